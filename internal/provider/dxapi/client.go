@@ -1,19 +1,69 @@
 package dxapi
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
+// ErrNotFound is returned when the requested object does not exist in DX.
+var ErrNotFound = errors.New("not found")
+
 type Client struct {
 	baseURL    string
+	appURL     string
 	token      string
 	httpClient *http.Client
 }
 
-func NewClient(baseURL, token string) *Client {
+// NewClient returns a client for the DX API served from baseURL. appURL is
+// where the DX web app is served from; if it is empty, it is only known for
+// the hosted DX API.
+func NewClient(baseURL, appURL, token string) *Client {
+	if appURL == "" {
+		appURL = knownAppURL(baseURL)
+	}
 	return &Client{
 		baseURL:    baseURL,
+		appURL:     strings.TrimSuffix(appURL, "/"),
 		token:      token,
 		httpClient: http.DefaultClient,
 	}
 }
+
+// appHosts maps the hosts the DX API is served from to the hosts of their web
+// app.
+var appHosts = map[string]string{
+	"api.getdx.com": "app.getdx.com",
+}
+
+// knownAppURL returns the web app URL for a DX API base URL, or "" if it is
+// not a known DX host.
+func knownAppURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+	host, ok := appHosts[u.Host]
+	if !ok {
+		return ""
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: host}).String()
+}
+
+// BaseURL returns the DX API base URL the client sends requests to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// ScorecardURL returns the link to a scorecard in the DX web app, or nil if
+// the client does not know where the web app is served from.
+func (c *Client) ScorecardURL(id string) *string {
+	if c.appURL == "" {
+		return nil
+	}
+	link := fmt.Sprintf("%s/scorecards/%s", c.appURL, url.PathEscape(id))
+	return &link
+}
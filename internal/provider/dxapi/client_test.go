@@ -0,0 +1,55 @@
+package dxapi
+
+import "testing"
+
+func TestScorecardURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		baseURL  string
+		appURL   string
+		expected *string
+	}{
+		"hosted": {
+			baseURL:  "https://api.getdx.com",
+			expected: str("https://app.getdx.com/scorecards/sc1"),
+		},
+		"configured": {
+			baseURL:  "https://dx-api.example.com",
+			appURL:   "https://dx.example.com/",
+			expected: str("https://dx.example.com/scorecards/sc1"),
+		},
+		"configured overrides hosted": {
+			baseURL:  "https://api.getdx.com",
+			appURL:   "https://dx.example.com",
+			expected: str("https://dx.example.com/scorecards/sc1"),
+		},
+		"unknown host": {
+			baseURL: "https://api.example.com",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewClient(testCase.baseURL, testCase.appURL, "token").ScorecardURL("sc1")
+			if (got == nil) != (testCase.expected == nil) || (got != nil && *got != *testCase.expected) {
+				t.Errorf("expected %v, got %v", deref(testCase.expected), deref(got))
+			}
+		})
+	}
+
+	if got := NewClient("https://api.getdx.com", "", "token").ScorecardURL("a/b"); got == nil || *got != "https://app.getdx.com/scorecards/a%2Fb" {
+		t.Errorf("expected the id to be escaped, got %v", deref(got))
+	}
+}
+
+func str(s string) *string { return &s }
+
+func deref(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
@@ -0,0 +1,136 @@
+package dxapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+)
+
+// APIEntityScore is the current result of a scorecard for one entity.
+type APIEntityScore struct {
+	EntityIdentifier string    `json:"entity_identifier"`
+	EntityName       *string   `json:"entity_name"`
+	Level            *APILevel `json:"level"`
+	Points           *int      `json:"points"`
+	PassingCheckIds  []string  `json:"passing_check_ids"`
+	FailingCheckIds  []string  `json:"failing_check_ids"`
+	EvaluatedAt      *string   `json:"evaluated_at"`
+}
+
+// APIEntityScoresResponse is a page of the entity scores of a scorecard.
+type APIEntityScoresResponse struct {
+	Ok               bool                `json:"ok"`
+	EntityScores     []APIEntityScore    `json:"entity_scores"`
+	ResponseMetadata APIResponseMetadata `json:"response_metadata"`
+}
+
+// ListEntityScores returns the current score of every entity a scorecard
+// evaluates, following pagination cursors until the last page has been read.
+func (c *Client) ListEntityScores(ctx context.Context, scorecardId string) ([]APIEntityScore, error) {
+	var scores []APIEntityScore
+
+	query := neturl.Values{}
+	query.Set("scorecard_id", scorecardId)
+	err := c.getPages(ctx, "scorecards.entityScores", query, func(body io.Reader) (string, error) {
+		var page APIEntityScoresResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return "", err
+		}
+		scores = append(scores, page.EntityScores...)
+		return page.ResponseMetadata.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// getPages reads every page of a paginated list endpoint. decode reads one
+// page and returns the cursor of the next one, or "" after the last page.
+func (c *Client) getPages(ctx context.Context, endpoint string, query neturl.Values, decode func(body io.Reader) (string, error)) error {
+	cursor := ""
+
+	for {
+		page := neturl.Values{}
+		for key, values := range query {
+			page[key] = values
+		}
+		page.Set("limit", "100")
+		if cursor != "" {
+			page.Set("cursor", cursor)
+		}
+
+		url := fmt.Sprintf("%s/%s?%s", c.baseURL, endpoint, page.Encode())
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("creating request: %w", err)
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+c.token)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("making HTTP request: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
+		}
+
+		cursor, err = decode(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("decoding API response: %w", err)
+		}
+
+		if cursor == "" {
+			return nil
+		}
+	}
+}
+
+// APICheckResult is the current result of a check for one entity.
+type APICheckResult struct {
+	EntityIdentifier string          `json:"entity_identifier"`
+	EntityName       *string         `json:"entity_name"`
+	Status           string          `json:"status"`
+	FilterMessage    *string         `json:"filter_message"`
+	Output           json.RawMessage `json:"output"`
+	EvaluatedAt      *string         `json:"evaluated_at"`
+}
+
+// APICheckResultsResponse is a page of the results of a check.
+type APICheckResultsResponse struct {
+	Ok               bool                `json:"ok"`
+	CheckResults     []APICheckResult    `json:"check_results"`
+	ResponseMetadata APIResponseMetadata `json:"response_metadata"`
+}
+
+// ListCheckResults returns the current result of a check for every entity the
+// scorecard evaluates, following pagination cursors until the last page has
+// been read.
+func (c *Client) ListCheckResults(ctx context.Context, scorecardId, checkId string) ([]APICheckResult, error) {
+	var results []APICheckResult
+
+	query := neturl.Values{}
+	query.Set("scorecard_id", scorecardId)
+	query.Set("check_id", checkId)
+	err := c.getPages(ctx, "scorecards.checkResults", query, func(body io.Reader) (string, error) {
+		var page APICheckResultsResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return "", err
+		}
+		results = append(results, page.CheckResults...)
+		return page.ResponseMetadata.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
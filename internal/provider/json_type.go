@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the JSON types fully satisfy framework interfaces.
+var (
+	_ basetypes.StringTypable                    = jsonStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonStringValue{}
+	_ xattr.ValidateableAttribute                = jsonStringValue{}
+)
+
+// jsonStringType is a string type holding a JSON object. Values of this type
+// are semantically equal when they decode to the same object, regardless of
+// key order or formatting. An empty string is treated as an empty object.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonStringValue{StringValue: in}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonStringValue{}
+}
+
+// jsonStringValue is a value of jsonStringType.
+type jsonStringValue struct {
+	basetypes.StringValue
+}
+
+func newJSONStringValue(value string) jsonStringValue {
+	return jsonStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonStringValue) Type(_ context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+func (v jsonStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	current, err := v.object()
+	if err != nil {
+		return false, diags
+	}
+	updated, err := newValue.object()
+	if err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(current, updated), diags
+}
+
+func (v jsonStringValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := v.object(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON object",
+			fmt.Sprintf("The value must be a JSON encoded object: %s", err.Error()),
+		)
+	}
+}
+
+// object decodes the value. Null, unknown and empty values decode to an
+// empty object.
+func (v jsonStringValue) object() (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	if v.IsNull() || v.IsUnknown() || strings.TrimSpace(v.ValueString()) == "" {
+		return object, nil
+	}
+
+	if err := json.Unmarshal([]byte(v.ValueString()), &object); err != nil {
+		return nil, err
+	}
+	if object == nil {
+		// The value was the JSON literal null.
+		object = map[string]interface{}{}
+	}
+	return object, nil
+}
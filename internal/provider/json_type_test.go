@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestJSONStringValueSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			current:  `{"unit":"ms"}`,
+			new:      `{"unit":"ms"}`,
+			expected: true,
+		},
+		"key-order": {
+			current:  `{"unit":"ms","decimals":2}`,
+			new:      `{"decimals":2,"unit":"ms"}`,
+			expected: true,
+		},
+		"formatting": {
+			current:  "{\n  \"unit\": \"ms\"\n}\n",
+			new:      `{"unit":"ms"}`,
+			expected: true,
+		},
+		"empty-string-and-empty-object": {
+			current:  "",
+			new:      "{}",
+			expected: true,
+		},
+		"different-value": {
+			current:  `{"decimals":2}`,
+			new:      `{"decimals":3}`,
+			expected: false,
+		},
+		"invalid": {
+			current:  `{"decimals":`,
+			new:      `{"decimals":2}`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := newJSONStringValue(testCase.current).StringSemanticEquals(context.Background(), newJSONStringValue(testCase.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestJSONStringValueValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     string
+		expectErr bool
+	}{
+		"empty":      {value: ""},
+		"object":     {value: `{"unit":"ms"}`},
+		"array":      {value: `["ms"]`, expectErr: true},
+		"malformed":  {value: `{"unit":`, expectErr: true},
+		"not-json":   {value: "ms", expectErr: true},
+		"json-null":  {value: "null"},
+		"whitespace": {value: "  "},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &xattr.ValidateAttributeResponse{}
+			newJSONStringValue(testCase.value).ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("output_custom_options")},
+				resp,
+			)
+			if got := resp.Diagnostics.HasError(); got != testCase.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
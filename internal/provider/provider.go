@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"terraform-provider-scorecard/internal/provider/dxapi"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -42,7 +45,24 @@ type scorecardProvider struct {
 
 // scorecardProviderModel describes the provider data model.
 type scorecardProviderModel struct {
-	ApiToken types.String `tfsdk:"api_token"`
+	ApiToken                        types.String `tfsdk:"api_token"`
+	AppUrl                          types.String `tfsdk:"app_url"`
+	DeletionProtection              types.Bool   `tfsdk:"deletion_protection"`
+	RequireAckForDestructiveChanges types.Bool   `tfsdk:"require_ack_for_destructive_changes"`
+}
+
+// scorecardProviderData is passed to resources and data sources when they are
+// configured.
+type scorecardProviderData struct {
+	client *dxapi.Client
+
+	// deletionProtection is the default for resources that do not set
+	// deletion_protection themselves.
+	deletionProtection bool
+
+	// requireAckForDestructiveChanges makes destructive changes fail to plan
+	// unless the resource acknowledges them.
+	requireAckForDestructiveChanges bool
 }
 
 func (p *scorecardProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,6 +78,18 @@ func (p *scorecardProvider) Schema(_ context.Context, _ provider.SchemaRequest,
                 Required:    true,
                 Sensitive:   true,
             },
+            "app_url": schema.StringAttribute{
+                Description: "URL of the DX web app, used to link to scorecards, such as 'https://app.getdx.com'. Only needed outside the hosted DX app, whose URL is known; otherwise 'url' attributes are left null.",
+                Optional:    true,
+            },
+            "deletion_protection": schema.BoolAttribute{
+                Description: "Default for 'deletion_protection' on scorecards that do not set it. Defaults to false. Changing it plans an in-place update of every such scorecard, which only changes Terraform state.",
+                Optional:    true,
+            },
+            "require_ack_for_destructive_changes": schema.BoolAttribute{
+                Description: "Whether destructive scorecard changes, such as removing checks from a published scorecard, fail to plan unless the scorecard sets 'acknowledge_destructive_changes'. Defaults to false, which only warns about them.",
+                Optional:    true,
+            },
         },
     }
 }
@@ -84,20 +116,42 @@ func (p *scorecardProvider) Configure(ctx context.Context, req provider.Configur
 
     // Initialize HTTP client
 	baseURL := "https://api.getdx.com"
-    client := dxapi.NewClient(baseURL, token)
+	appURL := config.AppUrl.ValueString()
+	if u, err := url.Parse(appURL); appURL != "" && (err != nil || u.Scheme == "" || u.Host == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("app_url"),
+			"Invalid App URL",
+			fmt.Sprintf("The app_url %q is not an absolute URL, such as \"https://app.getdx.com\".", appURL),
+		)
+		return
+	}
+    client := dxapi.NewClient(baseURL, appURL, token)
     // p.client = client
 
-	resp.ResourceData = client
-	// Set if we create a data source
-	// resp.DataSourceData = client
+	providerData := &scorecardProviderData{
+		client:                          client,
+		deletionProtection:              config.DeletionProtection.ValueBool(),
+		requireAckForDestructiveChanges: config.RequireAckForDestructiveChanges.ValueBool(),
+	}
+	resp.ResourceData = providerData
+	resp.DataSourceData = providerData
 }
 
 func (p *scorecardProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewScorecardResource,
+		NewScorecardCheckResource,
+		NewScorecardLevelResource,
+		NewScorecardCheckGroupResource,
 	}
 }
 
 func (p *scorecardProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewScorecardDataSource,
+		NewScorecardsDataSource,
+		NewScorecardEntityScoresDataSource,
+		NewScorecardCheckResultsDataSource,
+		NewScorecardEntityGateDataSource,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &scorecardCheckGroupResource{}
+	_ resource.ResourceWithImportState    = &scorecardCheckGroupResource{}
+	_ resource.ResourceWithValidateConfig = &scorecardCheckGroupResource{}
+)
+
+func NewScorecardCheckGroupResource() resource.Resource {
+	return &scorecardCheckGroupResource{}
+}
+
+// scorecardCheckGroupResource manages a single check group of a POINTS
+// scorecard.
+type scorecardCheckGroupResource struct {
+	client *dxapi.Client
+}
+
+// scorecardCheckGroupResourceModel describes the resource data model.
+type scorecardCheckGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ScorecardId types.String `tfsdk:"scorecard_id"`
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Ordering    types.Int64  `tfsdk:"ordering"`
+
+	FallbackCheckGroupKey types.String `tfsdk:"fallback_check_group_key"`
+}
+
+// payload builds the API representation of the check group.
+func (m scorecardCheckGroupResourceModel) payload() map[string]interface{} {
+	payload := map[string]interface{}{
+		"key":      m.Key.ValueString(),
+		"name":     m.Name.ValueString(),
+		"ordering": m.Ordering.ValueInt64(),
+	}
+	setIfKnown(payload, "id", m.Id)
+	return payload
+}
+
+// setFromAPI updates the model from a check group returned by the API. The key
+// is only replaced when DX returns one.
+func (m *scorecardCheckGroupResourceModel) setFromAPI(group *dxapi.APICheckGroup) {
+	m.Id = stringOrNull(group.Id)
+	m.Name = stringOrNull(group.Name)
+	m.Ordering = int64OrNull(group.Ordering)
+	if group.Key != nil && *group.Key != "" {
+		m.Key = types.StringValue(*group.Key)
+	}
+}
+
+func (r *scorecardCheckGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_group"
+}
+
+func (r *scorecardCheckGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.client
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (r *scorecardCheckGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single check group of a DX points scorecard. The scorecard_scorecard resource that owns the scorecard must not configure 'check_groups', or it removes check groups managed by this resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the check group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the points scorecard the check group belongs to. Changing it forces a new check group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The key of the check group, which scorecard_check resources reference it by. DX does not store check group keys, so the key is derived from the name, e.g. 'secret-scanning' for 'Secret Scanning', and a configured key must match it.",
+				PlanModifiers: []planmodifier.String{
+					keyFromName(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the check group.",
+			},
+			"ordering": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ordering of the check group. Defaults to placing the group after the existing check groups of the scorecard.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"fallback_check_group_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the check group that the checks of this group are moved to when it is destroyed. Without it, destroying a check group that still has checks fails.",
+			},
+		},
+	}
+}
+
+func (r *scorecardCheckGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scorecardCheckGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDerivedKey(path.Root("key"), config.Key, config.Name)...)
+}
+
+func (r *scorecardCheckGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scorecardCheckGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := plan.ScorecardId.ValueString()
+	existing := map[string]bool{}
+
+	apiResp, err := modifyScorecard(ctx, r.client, scorecardId, func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		for _, group := range scorecard.CheckGroups {
+			if group.Id != nil {
+				existing[*group.Id] = true
+			}
+		}
+		return checkGroupCreatePayload(scorecard, plan)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating check group", err.Error())
+		return
+	}
+
+	// The new check group is the one that did not exist before.
+	var created *dxapi.APICheckGroup
+	for _, group := range apiResp.Scorecard.CheckGroups {
+		if group.Id != nil && !existing[*group.Id] {
+			created = group
+			break
+		}
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating check group", fmt.Sprintf("Scorecard %s was updated, but the API response does not contain the new check group.", scorecardId))
+		return
+	}
+
+	plan.setFromAPI(created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scorecardCheckGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scorecardCheckGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := state.ScorecardId.ValueString()
+	apiResp, err := r.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading check group",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+
+	group := findAPICheckGroup(&apiResp.Scorecard, state.Id.ValueString())
+	if group == nil {
+		// The check group was removed outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromAPI(group)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *scorecardCheckGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scorecardCheckGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state scorecardCheckGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// DX does not store check group keys, so a new key or fallback alone
+	// only needs to be saved in state.
+	if plan.Name.Equal(state.Name) && plan.Ordering.Equal(state.Ordering) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	id := plan.Id.ValueString()
+	scorecardId := plan.ScorecardId.ValueString()
+
+	apiResp, err := modifyScorecard(ctx, r.client, scorecardId, func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		if err := validatePointsScorecard(scorecard); err != nil {
+			return nil, err
+		}
+
+		_, _, groups := scorecardReferences(scorecard)
+		replaced := false
+		for i, ref := range groups {
+			if ref["id"] == id {
+				groups[i] = plan.payload()
+				replaced = true
+			}
+		}
+		if !replaced {
+			return nil, fmt.Errorf("check group %s no longer exists in scorecard %q", id, scorecard.Name)
+		}
+
+		return map[string]interface{}{"check_groups": groups}, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating check group", err.Error())
+		return
+	}
+
+	group := findAPICheckGroup(&apiResp.Scorecard, id)
+	if group == nil {
+		resp.Diagnostics.AddError("Error updating check group", fmt.Sprintf("Scorecard %s was updated, but the API response does not contain check group %s.", scorecardId, id))
+		return
+	}
+
+	plan.setFromAPI(group)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scorecardCheckGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scorecardCheckGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := modifyScorecard(ctx, r.client, state.ScorecardId.ValueString(), func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		return checkGroupDeletePayload(scorecard, state.Id.ValueString(), state.FallbackCheckGroupKey.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting check group", err.Error())
+	}
+}
+
+// ImportState imports a check group by "<scorecard_id>/<check_group_key>",
+// where the key is derived from the check group name, or by
+// "<scorecard_id>/<check_group_id>".
+func (r *scorecardCheckGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scorecardId, ref, ok := strings.Cut(req.ID, "/")
+	if !ok || scorecardId == "" || ref == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form \"<scorecard_id>/<check_group_key>\", got: %q", req.ID),
+		)
+		return
+	}
+
+	apiResp, err := r.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing check group",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+	group, err := findImportedCheckGroup(&apiResp.Scorecard, ref)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing check group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scorecard_id"), scorecardId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), group.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), remoteCheckGroupKey(group))...)
+}
+
+// findImportedCheckGroup returns the check group an import ID refers to by
+// key, or else by id.
+func findImportedCheckGroup(scorecard *dxapi.APIScorecard, ref string) (*dxapi.APICheckGroup, error) {
+	group, err := findAPICheckGroupByKey(scorecard, ref)
+	if err == nil {
+		return group, nil
+	}
+	if group := findAPICheckGroup(scorecard, ref); group != nil {
+		return group, nil
+	}
+	return nil, err
+}
+
+// checkGroupCreatePayload builds the update that adds the planned check group
+// to the scorecard. Check group names must be unique, and so must the keys
+// derived from them, since check groups are looked up by key.
+func checkGroupCreatePayload(scorecard *dxapi.APIScorecard, plan scorecardCheckGroupResourceModel) (map[string]interface{}, error) {
+	if err := validatePointsScorecard(scorecard); err != nil {
+		return nil, err
+	}
+	if _, err := findAPICheckGroupByName(scorecard, plan.Name.ValueString()); err == nil {
+		return nil, fmt.Errorf("scorecard %q already has a check group named %q", scorecard.Name, plan.Name.ValueString())
+	}
+	if _, err := findAPICheckGroupByKey(scorecard, plan.Key.ValueString()); err == nil {
+		return nil, fmt.Errorf("scorecard %q already has a check group with key %q", scorecard.Name, plan.Key.ValueString())
+	}
+
+	group := plan
+	if group.Ordering.IsUnknown() {
+		group.Ordering = types.Int64Value(nextCheckGroupOrdering(scorecard))
+	}
+	group.Id = types.StringNull()
+
+	_, _, groups := scorecardReferences(scorecard)
+	groups = append(groups, group.payload())
+	return map[string]interface{}{"check_groups": groups}, nil
+}
+
+// checkGroupDeletePayload builds the update that removes the check group with
+// the id. Checks still in the group are moved to the fallback group, looked up
+// by key, or if there is none, the group is not removed and an error lists
+// them.
+func checkGroupDeletePayload(scorecard *dxapi.APIScorecard, id, fallbackKey string) (map[string]interface{}, error) {
+	checks, _, groups := scorecardReferences(scorecard)
+
+	// Leaving the check group out of the list removes it.
+	remaining := make([]map[string]interface{}, 0, len(groups))
+	for _, ref := range groups {
+		if ref["id"] != id {
+			remaining = append(remaining, ref)
+		}
+	}
+	payload := map[string]interface{}{"check_groups": remaining}
+
+	var moved []*dxapi.APICheck
+	var names []string
+	for _, chk := range scorecard.Checks {
+		if chk.Id == nil || chk.CheckGroup == nil || chk.CheckGroup.Id == nil || *chk.CheckGroup.Id != id {
+			continue
+		}
+		if chk.Name != nil {
+			names = append(names, *chk.Name)
+		}
+		moved = append(moved, chk)
+	}
+	if len(moved) == 0 {
+		return payload, nil
+	}
+
+	if fallbackKey == "" {
+		return nil, fmt.Errorf("the check group still has %d checks (%s). Move or remove them first, or set fallback_check_group_key to move them to another check group", len(names), strings.Join(names, ", "))
+	}
+	fallback, err := findAPICheckGroupByKey(scorecard, fallbackKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot move the checks of the check group: %w", err)
+	}
+	if fallback.Id == nil || *fallback.Id == id {
+		return nil, fmt.Errorf("fallback_check_group_key %q refers to the check group being destroyed", fallbackKey)
+	}
+
+	// Moved checks are sent in full, referencing the fallback group by the key
+	// scorecardReferences gives it.
+	for _, chk := range moved {
+		check := checkModelFromAPI(chk, checkModel{})
+		check.ScorecardCheckGroupKey = types.StringValue(*fallback.Id)
+		check.CheckGroup = nil
+		for i, ref := range checks {
+			if ref["id"] == *chk.Id {
+				checks[i] = checkPayload(check, scorecard.Type)
+			}
+		}
+	}
+
+	payload["checks"] = checks
+	return payload, nil
+}
+
+// validatePointsScorecard reports an error unless the scorecard has check groups.
+func validatePointsScorecard(scorecard *dxapi.APIScorecard) error {
+	if scorecard.Type != "POINTS" {
+		return fmt.Errorf("scorecard %q is a %s scorecard, check groups can only be added to POINTS scorecards", scorecard.Name, scorecard.Type)
+	}
+	return nil
+}
+
+// nextCheckGroupOrdering returns the ordering after the last check group of
+// the scorecard.
+func nextCheckGroupOrdering(scorecard *dxapi.APIScorecard) int64 {
+	next := int64(0)
+	for _, group := range scorecard.CheckGroups {
+		if group.Ordering != nil && int64(*group.Ordering) >= next {
+			next = int64(*group.Ordering) + 1
+		}
+	}
+	return next
+}
+
+// findAPICheckGroup returns the check group with the id, or nil if the
+// scorecard has no such check group.
+func findAPICheckGroup(scorecard *dxapi.APIScorecard, id string) *dxapi.APICheckGroup {
+	for _, group := range scorecard.CheckGroups {
+		if group.Id != nil && *group.Id == id {
+			return group
+		}
+	}
+	return nil
+}
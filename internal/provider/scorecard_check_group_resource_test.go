@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckGroupDeletePayload(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	ordering := func(n int) *int { return &n }
+	scorecard := &dxapi.APIScorecard{
+		Name: "Security",
+		Type: "POINTS",
+		CheckGroups: []*dxapi.APICheckGroup{
+			{Id: str("g1"), Name: str("Secrets"), Ordering: ordering(0)},
+			{Id: str("g2"), Name: str("Dependencies"), Ordering: ordering(1)},
+			{Id: str("g3"), Name: str("Empty"), Ordering: ordering(2)},
+			// Named so that its derived key collides with the one of g1.
+			{Id: str("g4"), Name: str("secrets"), Ordering: ordering(3)},
+		},
+		Checks: []*dxapi.APICheck{
+			{Id: str("c1"), Name: str("No leaked keys"), CheckGroup: &dxapi.APICheckGroup{Id: str("g1"), Name: str("Secrets")}},
+			{Id: str("c2"), Name: str("Pinned versions"), CheckGroup: &dxapi.APICheckGroup{Id: str("g2"), Name: str("Dependencies")}},
+		},
+	}
+
+	testCases := map[string]struct {
+		id          string
+		fallbackKey string
+		fallbackId  string
+		expectError bool
+		movesChecks bool
+	}{
+		"empty group":                {id: "g3"},
+		"checks without fallback":    {id: "g1", expectError: true},
+		"checks with fallback":       {id: "g1", fallbackKey: "dependencies", fallbackId: "g2", movesChecks: true},
+		"fallback with a shared key": {id: "g2", fallbackKey: "secrets", expectError: true},
+		"fallback by name":           {id: "g1", fallbackKey: "Dependencies", expectError: true},
+		"unknown fallback":           {id: "g1", fallbackKey: "licenses", expectError: true},
+		"fallback is the group":      {id: "g2", fallbackKey: "dependencies", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := checkGroupDeletePayload(scorecard, testCase.id, testCase.fallbackKey)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			groups := payload["check_groups"].([]map[string]interface{})
+			if len(groups) != 3 {
+				t.Errorf("expected 3 remaining check groups, got %d", len(groups))
+			}
+			for _, group := range groups {
+				if group["id"] == testCase.id {
+					t.Errorf("expected check group %s to be removed", testCase.id)
+				}
+			}
+
+			checks, ok := payload["checks"].([]map[string]interface{})
+			if ok != testCase.movesChecks {
+				t.Fatalf("expected checks in payload: %t, got: %t", testCase.movesChecks, ok)
+			}
+			if ok {
+				// The fallback group is referenced by the key scorecardReferences
+				// gives it, which is its id.
+				if got := checks[0]["scorecard_check_group_key"]; got != testCase.fallbackId {
+					t.Errorf("expected moved check in group %q, got %v", testCase.fallbackId, got)
+				}
+				if _, full := checks[1]["sql"]; full {
+					t.Errorf("expected other checks to be sent as references, got %v", checks[1])
+				}
+			}
+		})
+	}
+
+	if got := nextCheckGroupOrdering(scorecard); got != 4 {
+		t.Errorf("expected next ordering 4, got %d", got)
+	}
+}
+
+func TestCheckGroupCreatePayload(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	ordering := func(n int) *int { return &n }
+	scorecard := &dxapi.APIScorecard{
+		Name: "Security",
+		Type: "POINTS",
+		CheckGroups: []*dxapi.APICheckGroup{
+			{Id: str("g1"), Name: str("Secrets"), Ordering: ordering(0)},
+		},
+	}
+	group := func(key, name string) scorecardCheckGroupResourceModel {
+		return scorecardCheckGroupResourceModel{
+			Id:       types.StringUnknown(),
+			Key:      types.StringValue(key),
+			Name:     types.StringValue(name),
+			Ordering: types.Int64Unknown(),
+		}
+	}
+
+	payload, err := checkGroupCreatePayload(scorecard, group("dependencies", "Dependencies"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []map[string]interface{}{
+		{"id": "g1", "key": "g1"},
+		{"key": "dependencies", "name": "Dependencies", "ordering": int64(1)},
+	}
+	if !reflect.DeepEqual(payload["check_groups"], expected) {
+		t.Errorf("expected check groups %v, got %v", expected, payload["check_groups"])
+	}
+
+	if _, err := checkGroupCreatePayload(scorecard, group("secrets", "Secrets")); err == nil {
+		t.Error("expected an error for a duplicate check group name")
+	}
+	if _, err := checkGroupCreatePayload(scorecard, group("secrets", "secrets")); err == nil {
+		t.Error("expected an error for a duplicate check group key")
+	}
+
+	// Reading the group back keeps the planned key.
+	created := group("dependencies", "Dependencies")
+	created.setFromAPI(&dxapi.APICheckGroup{Id: str("g2"), Name: str("Dependencies"), Ordering: ordering(1)})
+	if created.Key.ValueString() != "dependencies" || created.Id.ValueString() != "g2" {
+		t.Errorf("expected check group g2 with key dependencies, got %s with key %s", created.Id, created.Key)
+	}
+	if findAPICheckGroup(scorecard, "g1") == nil || findAPICheckGroup(scorecard, "secrets") != nil {
+		t.Error("expected check groups to be found by id only")
+	}
+}
+
+func TestFindImportedCheckGroup(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	scorecard := &dxapi.APIScorecard{
+		Name: "Security",
+		CheckGroups: []*dxapi.APICheckGroup{
+			{Id: str("g1"), Name: str("Secret Scanning")},
+			{Id: str("g2"), Name: str("Dependencies")},
+			{Id: str("g3"), Name: str("Docs")},
+			{Id: str("g4"), Name: str("docs")},
+		},
+	}
+
+	testCases := map[string]struct {
+		ref         string
+		expectedId  string
+		expectError bool
+	}{
+		"key": {
+			ref:        "secret-scanning",
+			expectedId: "g1",
+		},
+		"id": {
+			ref:        "g2",
+			expectedId: "g2",
+		},
+		"name": {
+			ref:         "Secret Scanning",
+			expectError: true,
+		},
+		"ambiguous key": {
+			ref:         "docs",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			group, err := findImportedCheckGroup(scorecard, testCase.ref)
+			if (err != nil) != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, err)
+			}
+			if err == nil && *group.Id != testCase.expectedId {
+				t.Errorf("expected check group %s, got %s", testCase.expectedId, *group.Id)
+			}
+		})
+	}
+}
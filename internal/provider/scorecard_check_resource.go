@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &scorecardCheckResource{}
+	_ resource.ResourceWithImportState    = &scorecardCheckResource{}
+	_ resource.ResourceWithValidateConfig = &scorecardCheckResource{}
+)
+
+func NewScorecardCheckResource() resource.Resource {
+	return &scorecardCheckResource{}
+}
+
+// scorecardCheckResource manages a single check of a scorecard, for teams that
+// own checks separately from the scorecard itself.
+type scorecardCheckResource struct {
+	client *dxapi.Client
+}
+
+// scorecardCheckResourceModel describes the resource data model.
+type scorecardCheckResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ScorecardId types.String `tfsdk:"scorecard_id"`
+
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Ordering      types.Int64    `tfsdk:"ordering"`
+	Sql           sqlStringValue `tfsdk:"sql"`
+	FilterSql     sqlStringValue `tfsdk:"filter_sql"`
+	FilterMessage types.String   `tfsdk:"filter_message"`
+	OutputEnabled types.Bool     `tfsdk:"output_enabled"`
+
+	OutputType          types.String    `tfsdk:"output_type"`
+	OutputAggregation   types.String    `tfsdk:"output_aggregation"`
+	OutputCustomOptions jsonStringValue `tfsdk:"output_custom_options"`
+
+	EstimatedDevDays types.Int64  `tfsdk:"estimated_dev_days"`
+	ExternalUrl      types.String `tfsdk:"external_url"`
+	Published        types.Bool   `tfsdk:"published"`
+
+	// Additional field for level based scorecards
+	ScorecardLevelKey types.String `tfsdk:"scorecard_level_key"`
+
+	// Additional fields for points based scorecards
+	ScorecardCheckGroupKey types.String `tfsdk:"scorecard_check_group_key"`
+	Points                 types.Int64  `tfsdk:"points"`
+}
+
+// check returns the check the model describes, without its level or check
+// group; see resolvedCheck.
+func (m scorecardCheckResourceModel) check() checkModel {
+	return checkModel{
+		Id:                     m.Id,
+		Name:                   m.Name,
+		Description:            m.Description,
+		Ordering:               m.Ordering,
+		Sql:                    m.Sql,
+		FilterSql:              m.FilterSql,
+		FilterMessage:          m.FilterMessage,
+		OutputEnabled:          m.OutputEnabled,
+		OutputType:             m.OutputType,
+		OutputAggregation:      m.OutputAggregation,
+		OutputCustomOptions:    m.OutputCustomOptions,
+		EstimatedDevDays:       m.EstimatedDevDays,
+		ExternalUrl:            m.ExternalUrl,
+		Published:              m.Published,
+		ScorecardLevelKey:      types.StringNull(),
+		ScorecardCheckGroupKey: types.StringNull(),
+		Points:                 m.Points,
+	}
+}
+
+// resolvedCheck returns the check the model describes, with its level or
+// check group looked up by key in the scorecard. The check references it by
+// the key scorecardReferences gives it in the same request, so the level or
+// check group must be sent along with the check.
+func (m scorecardCheckResourceModel) resolvedCheck(scorecard *dxapi.APIScorecard) (checkModel, error) {
+	check := m.check()
+
+	switch scorecard.Type {
+	case "LEVEL":
+		if m.ScorecardLevelKey.IsNull() {
+			return check, fmt.Errorf("scorecard %q is a LEVEL scorecard, so the check needs a scorecard_level_key", scorecard.Name)
+		}
+		level, err := findAPILevelByKey(scorecard, m.ScorecardLevelKey.ValueString())
+		if err != nil {
+			return check, err
+		}
+		check.ScorecardLevelKey = stringOrNull(level.Id)
+	case "POINTS":
+		if m.ScorecardCheckGroupKey.IsNull() {
+			return check, fmt.Errorf("scorecard %q is a POINTS scorecard, so the check needs a scorecard_check_group_key", scorecard.Name)
+		}
+		group, err := findAPICheckGroupByKey(scorecard, m.ScorecardCheckGroupKey.ValueString())
+		if err != nil {
+			return check, err
+		}
+		check.ScorecardCheckGroupKey = stringOrNull(group.Id)
+	default:
+		return check, fmt.Errorf("scorecard %q has unsupported type %q", scorecard.Name, scorecard.Type)
+	}
+
+	return check, nil
+}
+
+// setFromAPI updates the model from a check returned by the API. The level
+// and check group keys are those of the level and check group the check
+// belongs to in DX, so moving the check outside of Terraform shows as drift.
+func (m *scorecardCheckResourceModel) setFromAPI(scorecard *dxapi.APIScorecard, chk *dxapi.APICheck) {
+	check := checkModelFromAPI(chk, m.check())
+	m.ScorecardLevelKey = types.StringNull()
+	if chk.Level != nil {
+		m.ScorecardLevelKey = apiLevelKey(scorecard, chk.Level)
+	}
+	m.ScorecardCheckGroupKey = types.StringNull()
+	if chk.CheckGroup != nil {
+		m.ScorecardCheckGroupKey = apiCheckGroupKey(scorecard, chk.CheckGroup)
+	}
+
+	m.Id = check.Id
+	m.Name = check.Name
+	m.Description = check.Description
+	m.Ordering = check.Ordering
+	m.Sql = check.Sql
+	m.FilterSql = check.FilterSql
+	m.FilterMessage = check.FilterMessage
+	m.OutputEnabled = check.OutputEnabled
+	m.OutputType = check.OutputType
+	m.OutputAggregation = check.OutputAggregation
+	m.OutputCustomOptions = check.OutputCustomOptions
+	m.EstimatedDevDays = check.EstimatedDevDays
+	m.ExternalUrl = check.ExternalUrl
+	m.Published = check.Published
+	m.Points = check.Points
+}
+
+func (r *scorecardCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check"
+}
+
+func (r *scorecardCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.client
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (r *scorecardCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single check of a DX Scorecard. The scorecard_scorecard resource that owns the scorecard must set checks_management to 'additive' or 'ignore', or it removes checks managed by this resource.",
+		Attributes: checkAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the check.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scorecard the check belongs to. Changing it forces a new check.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ordering": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ordering of the check. Defaults to placing the check after the existing checks of the scorecard.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"scorecard_level_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the level the check belongs to (levels scorecards only), as in scorecard_level. DX does not store level keys, so it is derived from the level name, e.g. 'gold-tier' for 'Gold Tier'.",
+			},
+			"scorecard_check_group_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the check group the check belongs to (points scorecards only), as in scorecard_check_group. DX does not store check group keys, so it is derived from the check group name.",
+			},
+		}),
+	}
+}
+
+func (r *scorecardCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scorecardCheckResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCheckOutput(path.Empty(), config.check())...)
+}
+
+func (r *scorecardCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scorecardCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := plan.ScorecardId.ValueString()
+	existing := map[string]bool{}
+
+	apiResp, err := modifyScorecard(ctx, r.client, scorecardId, func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		for _, chk := range scorecard.Checks {
+			if chk.Id != nil {
+				existing[*chk.Id] = true
+			}
+		}
+		return checkCreatePayload(scorecard, plan)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating check", err.Error())
+		return
+	}
+
+	// The new check is the one that did not exist before.
+	var created *dxapi.APICheck
+	for _, chk := range apiResp.Scorecard.Checks {
+		if chk.Id != nil && !existing[*chk.Id] {
+			created = chk
+			break
+		}
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating check", fmt.Sprintf("Scorecard %s was updated, but the API response does not contain the new check.", scorecardId))
+		return
+	}
+
+	plan.setFromAPI(&apiResp.Scorecard, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scorecardCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scorecardCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := state.ScorecardId.ValueString()
+	apiResp, err := r.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading check",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+
+	chk, _ := findAPICheck(&apiResp.Scorecard, state.Id.ValueString())
+	if chk == nil {
+		// The check was removed outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromAPI(&apiResp.Scorecard, chk)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *scorecardCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scorecardCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.Id.ValueString()
+	scorecardId := plan.ScorecardId.ValueString()
+
+	apiResp, err := modifyScorecard(ctx, r.client, scorecardId, func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		return checkUpdatePayload(scorecard, plan)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating check", err.Error())
+		return
+	}
+
+	chk, _ := findAPICheck(&apiResp.Scorecard, id)
+	if chk == nil {
+		resp.Diagnostics.AddError("Error updating check", fmt.Sprintf("Scorecard %s was updated, but the API response does not contain check %s.", scorecardId, id))
+		return
+	}
+
+	plan.setFromAPI(&apiResp.Scorecard, chk)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scorecardCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scorecardCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueString()
+	_, err := modifyScorecard(ctx, r.client, state.ScorecardId.ValueString(), func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		return checkDeletePayload(scorecard, id), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting check", err.Error())
+	}
+}
+
+// ImportState imports a check by "<scorecard_id>/<check_id>".
+func (r *scorecardCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scorecardId, id, ok := strings.Cut(req.ID, "/")
+	if !ok || scorecardId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form \"<scorecard_id>/<check_id>\", got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scorecard_id"), scorecardId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// checkCreatePayload builds the update that adds the planned check to the
+// scorecard, after its existing checks unless an ordering is configured.
+func checkCreatePayload(scorecard *dxapi.APIScorecard, plan scorecardCheckResourceModel) (map[string]interface{}, error) {
+	check, err := plan.resolvedCheck(scorecard)
+	if err != nil {
+		return nil, err
+	}
+	if check.Ordering.IsUnknown() {
+		check.Ordering = types.Int64Value(int64(len(scorecard.Checks)))
+	}
+	check.Id = types.StringNull()
+
+	checks, levels, groups := scorecardReferences(scorecard)
+	checks = append(checks, checkPayload(check, scorecard.Type))
+
+	payload := map[string]interface{}{"checks": checks}
+	setScorecardReferences(payload, scorecard.Type, levels, groups)
+	return payload, nil
+}
+
+// checkUpdatePayload builds the update that replaces the planned check in the
+// scorecard, leaving its other checks unchanged.
+func checkUpdatePayload(scorecard *dxapi.APIScorecard, plan scorecardCheckResourceModel) (map[string]interface{}, error) {
+	id := plan.Id.ValueString()
+	check, err := plan.resolvedCheck(scorecard)
+	if err != nil {
+		return nil, err
+	}
+
+	checks, levels, groups := scorecardReferences(scorecard)
+	replaced := false
+	for i, ref := range checks {
+		if ref["id"] == id {
+			checks[i] = checkPayload(check, scorecard.Type)
+			replaced = true
+		}
+	}
+	if !replaced {
+		return nil, fmt.Errorf("check %s no longer exists in scorecard %q", id, scorecard.Name)
+	}
+
+	payload := map[string]interface{}{"checks": checks}
+	setScorecardReferences(payload, scorecard.Type, levels, groups)
+	return payload, nil
+}
+
+// checkDeletePayload builds the update that removes the check with the id
+// from the scorecard. Leaving the check out of the list removes it.
+func checkDeletePayload(scorecard *dxapi.APIScorecard, id string) map[string]interface{} {
+	checks, levels, groups := scorecardReferences(scorecard)
+
+	remaining := make([]map[string]interface{}, 0, len(checks))
+	for _, ref := range checks {
+		if ref["id"] != id {
+			remaining = append(remaining, ref)
+		}
+	}
+
+	payload := map[string]interface{}{"checks": remaining}
+	setScorecardReferences(payload, scorecard.Type, levels, groups)
+	return payload
+}
+
+// apiLevelKey returns the key of a level a check references, looked up in the
+// levels of the scorecard if the reference omits its name.
+func apiLevelKey(scorecard *dxapi.APIScorecard, ref *dxapi.APILevel) types.String {
+	if ref.Name != nil {
+		return types.StringValue(remoteLevelKey(ref))
+	}
+	for _, level := range scorecard.Levels {
+		if ref.Id != nil && level.Id != nil && *level.Id == *ref.Id && level.Name != nil {
+			return types.StringValue(remoteLevelKey(level))
+		}
+	}
+	return types.StringNull()
+}
+
+// apiCheckGroupKey returns the key of a check group a check references, looked
+// up in the check groups of the scorecard if the reference omits its name.
+func apiCheckGroupKey(scorecard *dxapi.APIScorecard, ref *dxapi.APICheckGroup) types.String {
+	if ref.Name != nil {
+		return types.StringValue(remoteCheckGroupKey(ref))
+	}
+	for _, group := range scorecard.CheckGroups {
+		if ref.Id != nil && group.Id != nil && *group.Id == *ref.Id && group.Name != nil {
+			return types.StringValue(remoteCheckGroupKey(group))
+		}
+	}
+	return types.StringNull()
+}
+
+// findAPICheck returns the check with the id and its index, or nil and -1 if
+// the scorecard has no such check.
+func findAPICheck(scorecard *dxapi.APIScorecard, id string) (*dxapi.APICheck, int) {
+	for i, chk := range scorecard.Checks {
+		if chk.Id != nil && *chk.Id == id {
+			return chk, i
+		}
+	}
+	return nil, -1
+}
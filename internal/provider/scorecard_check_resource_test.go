@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// checkResource returns the model of a check resource on a level of a levels
+// scorecard.
+func checkResource(id types.String, name, levelKey string) scorecardCheckResourceModel {
+	return scorecardCheckResourceModel{
+		Id:                     id,
+		ScorecardId:            types.StringValue("sc1"),
+		Name:                   types.StringValue(name),
+		Description:            types.StringValue(""),
+		Ordering:               types.Int64Unknown(),
+		Sql:                    newSQLStringValue("SELECT 1"),
+		FilterSql:              newSQLStringValue(""),
+		FilterMessage:          types.StringValue(""),
+		OutputEnabled:          types.BoolValue(false),
+		OutputType:             types.StringNull(),
+		OutputAggregation:      types.StringNull(),
+		OutputCustomOptions:    newJSONStringValue(""),
+		EstimatedDevDays:       types.Int64Null(),
+		ExternalUrl:            types.StringValue(""),
+		Published:              types.BoolValue(true),
+		ScorecardLevelKey:      types.StringValue(levelKey),
+		ScorecardCheckGroupKey: types.StringNull(),
+		Points:                 types.Int64Null(),
+	}
+}
+
+func TestFindAPICheck(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	scorecard := &dxapi.APIScorecard{
+		Checks: []*dxapi.APICheck{
+			{Id: str("c1"), Name: str("Has owner")},
+			{Id: str("c2"), Name: str("Has runbook")},
+		},
+	}
+
+	if chk, i := findAPICheck(scorecard, "c2"); chk == nil || *chk.Id != "c2" || i != 1 {
+		t.Errorf("expected check c2 at index 1, got %v at %d", chk, i)
+	}
+	if chk, i := findAPICheck(scorecard, "c3"); chk != nil || i != -1 {
+		t.Errorf("expected no check for a removed id, got %v at %d", chk, i)
+	}
+}
+
+func TestCheckPayloads(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	scorecard := &dxapi.APIScorecard{
+		Name: "Production Readiness",
+		Type: "LEVEL",
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Name: str("Bronze")},
+			{Id: str("l2"), Name: str("Gold Tier")},
+		},
+		Checks: []*dxapi.APICheck{
+			{Id: str("c1"), Name: str("Has owner")},
+			{Id: str("c2"), Name: str("Has runbook")},
+		},
+	}
+	levels := []map[string]interface{}{
+		{"id": "l1", "key": "l1"},
+		{"id": "l2", "key": "l2"},
+	}
+	// expectedCheck is the check as sent, referencing its level by the key
+	// scorecardReferences gives it.
+	expectedCheck := func(id types.String, name string, ordering int64) map[string]interface{} {
+		check := checkResource(id, name, "gold-tier").check()
+		check.Ordering = types.Int64Value(ordering)
+		check.ScorecardLevelKey = types.StringValue("l2")
+		return checkPayload(check, "LEVEL")
+	}
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		payload, err := checkCreatePayload(scorecard, checkResource(types.StringUnknown(), "Has SLOs", "gold-tier"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		expected := map[string]interface{}{
+			"checks": []map[string]interface{}{
+				{"id": "c1"},
+				{"id": "c2"},
+				expectedCheck(types.StringNull(), "Has SLOs", 2),
+			},
+			"levels": levels,
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("expected %v, got %v", expected, payload)
+		}
+
+		if _, err := checkCreatePayload(scorecard, checkResource(types.StringUnknown(), "Has SLOs", "platinum")); err == nil {
+			t.Error("expected an error for an unknown level key")
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		plan := checkResource(types.StringValue("c2"), "Has a runbook", "gold-tier")
+		plan.Ordering = types.Int64Value(1)
+		payload, err := checkUpdatePayload(scorecard, plan)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		expected := map[string]interface{}{
+			"checks": []map[string]interface{}{
+				{"id": "c1"},
+				expectedCheck(types.StringValue("c2"), "Has a runbook", 1),
+			},
+			"levels": levels,
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("expected %v, got %v", expected, payload)
+		}
+
+		if _, err := checkUpdatePayload(scorecard, checkResource(types.StringValue("c3"), "Has SLOs", "gold-tier")); err == nil {
+			t.Error("expected an error for a check that no longer exists")
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		payload := checkDeletePayload(scorecard, "c1")
+		expected := map[string]interface{}{
+			"checks": []map[string]interface{}{{"id": "c2"}},
+			"levels": levels,
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("expected %v, got %v", expected, payload)
+		}
+	})
+}
+
+func TestCheckSetFromAPI(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+	scorecard := &dxapi.APIScorecard{
+		Type:   "LEVEL",
+		Levels: []*dxapi.APILevel{{Id: str("l2"), Name: str("Gold Tier")}},
+	}
+
+	// The check references its level by id only, and was moved to another
+	// level outside of Terraform.
+	check := checkResource(types.StringUnknown(), "Has SLOs", "bronze")
+	check.setFromAPI(scorecard, &dxapi.APICheck{
+		Id:       str("c3"),
+		Name:     str("Has SLOs"),
+		Ordering: num(2),
+		Sql:      str("SELECT 1"),
+		Level:    &dxapi.APILevel{Id: str("l2")},
+	})
+
+	if check.Id.ValueString() != "c3" || check.Ordering.ValueInt64() != 2 {
+		t.Errorf("expected check c3 with ordering 2, got %s with ordering %s", check.Id, check.Ordering)
+	}
+	if check.ScorecardLevelKey.ValueString() != "gold-tier" {
+		t.Errorf("expected the level key to be looked up, got %s", check.ScorecardLevelKey)
+	}
+	if !check.ScorecardCheckGroupKey.IsNull() {
+		t.Errorf("expected no check group key, got %s", check.ScorecardCheckGroupKey)
+	}
+}
+
+func TestCheckValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &scorecardCheckResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	testCases := map[string]struct {
+		outputEnabled bool
+		outputType    types.String
+		expectError   bool
+	}{
+		"output disabled": {
+			outputType: types.StringNull(),
+		},
+		"output enabled": {
+			outputEnabled: true,
+			outputType:    types.StringValue("integer"),
+		},
+		"output type without output": {
+			outputType:  types.StringValue("integer"),
+			expectError: true,
+		},
+		"unsupported output type": {
+			outputEnabled: true,
+			outputType:    types.StringValue("bytes"),
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			check := checkResource(types.StringNull(), "Has SLOs", "gold-tier")
+			check.Ordering = types.Int64Null()
+			check.OutputEnabled = types.BoolValue(testCase.outputEnabled)
+			check.OutputType = testCase.outputType
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := state.Set(ctx, check); diags.HasError() {
+				t.Fatalf("unexpected diagnostics building config: %v", diags)
+			}
+
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// fakeScorecardServer serves a single scorecard, applying updates the way DX
+// does: checks sent as bare id references are kept, and checks sent in full
+// are created or replaced.
+type fakeScorecardServer struct {
+	mu        sync.Mutex
+	scorecard dxapi.APIScorecard
+	created   int
+}
+
+func (s *fakeScorecardServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		s.mu.Lock()
+		body, _ := json.Marshal(dxapi.APIResponse{Ok: true, Scorecard: s.scorecard})
+		s.mu.Unlock()
+		// Give parallel updates a chance to read the same scorecard.
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write(body)
+		return
+	}
+
+	var payload struct {
+		Checks []map[string]interface{} `json:"checks"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var checks []*dxapi.APICheck
+	for _, ref := range payload.Checks {
+		id, _ := ref["id"].(string)
+		name, full := ref["name"].(string)
+		if !full {
+			if chk, _ := findAPICheck(&s.scorecard, id); chk != nil {
+				checks = append(checks, chk)
+			}
+			continue
+		}
+		if id == "" {
+			s.created++
+			id = fmt.Sprintf("c%d", s.created)
+		}
+		checks = append(checks, &dxapi.APICheck{Id: &id, Name: &name})
+	}
+	s.scorecard.Checks = checks
+	body, _ := json.Marshal(dxapi.APIResponse{Ok: true, Scorecard: s.scorecard})
+	_, _ = w.Write(body)
+}
+
+func TestModifyScorecardConcurrently(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	fake := &fakeScorecardServer{
+		scorecard: dxapi.APIScorecard{
+			Id:     "sc-concurrent",
+			Name:   "Production Readiness",
+			Type:   "LEVEL",
+			Levels: []*dxapi.APILevel{{Id: str("l1"), Name: str("Gold Tier")}},
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := dxapi.NewClient(server.URL, "", "token")
+
+	// Check resources on the same scorecard are created in parallel. Each
+	// reads the scorecard and writes it back with its check added, so
+	// without the lock the last write would drop the others' checks.
+	const count = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			plan := checkResource(types.StringUnknown(), fmt.Sprintf("Check %d", i), "gold-tier")
+			_, err := modifyScorecard(context.Background(), client, "sc-concurrent", func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+				return checkCreatePayload(scorecard, plan)
+			})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if got := len(fake.scorecard.Checks); got != count {
+		t.Errorf("expected %d checks after parallel creates, got %d", count, got)
+	}
+}
+
+func TestKeyedMutex(t *testing.T) {
+	t.Parallel()
+
+	locks := &keyedMutex{locks: map[string]*sync.Mutex{}}
+	unlock := locks.lock("sc1")
+
+	// Another scorecard is not blocked.
+	done := make(chan struct{})
+	go func() {
+		locks.lock("sc2")()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the lock of another key not to block")
+	}
+
+	// The same scorecard is blocked until it is unlocked.
+	acquired := make(chan struct{})
+	go func() {
+		locks.lock("sc1")()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("expected the lock of the same key to block")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected the lock to be acquired after unlocking")
+	}
+}
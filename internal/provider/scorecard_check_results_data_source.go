@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &scorecardCheckResultsDataSource{}
+
+func NewScorecardCheckResultsDataSource() datasource.DataSource {
+	return &scorecardCheckResultsDataSource{}
+}
+
+// Check result statuses.
+const (
+	checkStatusPass     = "pass"
+	checkStatusFail     = "fail"
+	checkStatusFiltered = "filtered"
+)
+
+// scorecardCheckResultsDataSource reads the current results of a check.
+type scorecardCheckResultsDataSource struct {
+	client *dxapi.Client
+}
+
+// scorecardCheckResultsDataSourceModel describes the data source data model.
+type scorecardCheckResultsDataSourceModel struct {
+	ScorecardId types.String `tfsdk:"scorecard_id"`
+	CheckId     types.String `tfsdk:"check_id"`
+	Status      types.String `tfsdk:"status"`
+
+	CheckName         types.String `tfsdk:"check_name"`
+	ExternalUrl       types.String `tfsdk:"external_url"`
+	OutputType        types.String `tfsdk:"output_type"`
+	OutputAggregation types.String `tfsdk:"output_aggregation"`
+	AggregatedOutput  types.String `tfsdk:"aggregated_output"`
+
+	Results []checkResultModel `tfsdk:"results"`
+}
+
+// checkResultModel is the result of a check for one entity.
+type checkResultModel struct {
+	EntityIdentifier types.String `tfsdk:"entity_identifier"`
+	EntityName       types.String `tfsdk:"entity_name"`
+	Status           types.String `tfsdk:"status"`
+	FilterMessage    types.String `tfsdk:"filter_message"`
+	Output           types.String `tfsdk:"output"`
+	EvaluatedAt      types.String `tfsdk:"evaluated_at"`
+}
+
+func (d *scorecardCheckResultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_results"
+}
+
+func (d *scorecardCheckResultsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *scorecardCheckResultsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current results of a check of a DX Scorecard for the entities it evaluates.",
+		Attributes: map[string]schema.Attribute{
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scorecard.",
+			},
+			"check_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the check.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read the results with this status. Options: 'pass', 'fail', 'filtered'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(checkStatusPass, checkStatusFail, checkStatusFiltered),
+				},
+			},
+			"check_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the check.",
+			},
+			"external_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to documentation for the check.",
+			},
+			"output_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the check output. Null if the check has no output.",
+			},
+			"output_aggregation": schema.StringAttribute{
+				Computed:    true,
+				Description: "How the check outputs are aggregated. Null if the check has no output.",
+			},
+			"aggregated_output": schema.StringAttribute{
+				Computed:    true,
+				Description: "The outputs of the returned results, aggregated according to 'output_aggregation' and formatted like 'output'. Null if the check has no output, or the outputs cannot be aggregated.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The results of the check, one per entity.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity_identifier": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier of the entity.",
+						},
+						"entity_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the entity.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the entity passes the check, fails it, or was excluded by the check's 'filter_sql'. One of 'pass', 'fail', 'filtered'.",
+						},
+						"filter_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Why the entity was excluded from the check. Null unless the status is 'filtered'.",
+						},
+						"output": schema.StringAttribute{
+							Computed:    true,
+							Description: "The output of the check for the entity, formatted according to the check's output type and custom output options. Null if the check has no output.",
+						},
+						"evaluated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When DX last evaluated the check for the entity.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *scorecardCheckResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scorecardCheckResultsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := data.ScorecardId.ValueString()
+	checkId := data.CheckId.ValueString()
+
+	// The check defines how its outputs are formatted.
+	apiResp, err := d.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading scorecard",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+	check, _ := findAPICheck(&apiResp.Scorecard, checkId)
+	if check == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("check_id"),
+			"Check not found",
+			fmt.Sprintf("Scorecard %q (ID %s) has no check with ID %s.", apiResp.Scorecard.Name, scorecardId, checkId),
+		)
+		return
+	}
+
+	results, err := d.client.ListCheckResults(ctx, scorecardId, checkId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading check results",
+			fmt.Sprintf("Could not read the results of check ID %s: %s", checkId, err.Error()),
+		)
+		return
+	}
+
+	format := newOutputFormat(check)
+	data.CheckName = stringOrNull(check.Name)
+	data.ExternalUrl = stringOrNull(check.ExternalUrl)
+	data.OutputType = types.StringNull()
+	data.OutputAggregation = types.StringNull()
+	if check.OutputEnabled {
+		data.OutputType = stringOrNull(check.OutputType)
+		data.OutputAggregation = stringOrNull(check.OutputAggregation)
+	}
+
+	data.Results = []checkResultModel{}
+	var outputs []json.RawMessage
+	for _, result := range results {
+		status := strings.ToLower(result.Status)
+		if !data.Status.IsNull() && status != data.Status.ValueString() {
+			continue
+		}
+
+		model := checkResultModel{
+			EntityIdentifier: types.StringValue(result.EntityIdentifier),
+			EntityName:       stringOrNull(result.EntityName),
+			Status:           types.StringValue(status),
+			FilterMessage:    types.StringNull(),
+			Output:           format.value(result.Output),
+			EvaluatedAt:      stringOrNull(result.EvaluatedAt),
+		}
+		if status == checkStatusFiltered {
+			model.FilterMessage = stringOrNull(result.FilterMessage)
+			if model.FilterMessage.IsNull() {
+				model.FilterMessage = stringOrNull(check.FilterMessage)
+			}
+		} else {
+			outputs = append(outputs, result.Output)
+		}
+		data.Results = append(data.Results, model)
+	}
+	data.AggregatedOutput = format.aggregate(outputs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// outputFormat formats the outputs of a check according to its output type,
+// aggregation and custom output options.
+type outputFormat struct {
+	enabled     bool
+	outputType  string
+	aggregation string
+	unit        string
+	decimals    int
+}
+
+func newOutputFormat(check *dxapi.APICheck) outputFormat {
+	format := outputFormat{enabled: check.OutputEnabled, decimals: -1}
+	if check.OutputType != nil {
+		format.outputType = strings.ToLower(*check.OutputType)
+	}
+	if check.OutputAggregation != nil {
+		format.aggregation = strings.ToLower(*check.OutputAggregation)
+	}
+
+	options, err := jsonOrEmpty(check.OutputCustomOptions).object()
+	if err != nil {
+		return format
+	}
+	if unit, ok := options["unit"].(string); ok {
+		format.unit = unit
+	}
+	if decimals, ok := options["decimals"].(float64); ok && decimals >= 0 {
+		format.decimals = int(decimals)
+	}
+	return format
+}
+
+// value formats the output of one entity.
+func (f outputFormat) value(raw json.RawMessage) types.String {
+	if !f.enabled || len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+
+	var output interface{}
+	if err := json.Unmarshal(raw, &output); err != nil {
+		return types.StringValue(string(raw))
+	}
+
+	switch output := output.(type) {
+	case float64:
+		return types.StringValue(f.number(output))
+	case string:
+		if f.outputType != stringOutputType {
+			if n, err := strconv.ParseFloat(output, 64); err == nil {
+				return types.StringValue(f.number(n))
+			}
+		}
+		return types.StringValue(output)
+	case bool:
+		return types.StringValue(strconv.FormatBool(output))
+	default:
+		return types.StringValue(string(raw))
+	}
+}
+
+// number formats a numeric output with the configured decimals and unit.
+// Percentages are suffixed with a percent sign unless the unit says otherwise.
+func (f outputFormat) number(n float64) string {
+	formatted := strconv.FormatFloat(n, 'f', f.decimals, 64)
+	switch {
+	case f.unit != "":
+		formatted += " " + f.unit
+	case f.outputType == "percent" || f.outputType == "percentage":
+		formatted += "%"
+	}
+	return formatted
+}
+
+// aggregate aggregates outputs according to the check's aggregation. Outputs
+// are counted whatever their type; the other aggregations only use numeric
+// outputs. It returns null when the check has no output or no known
+// aggregation, or when there is no numeric output to aggregate.
+func (f outputFormat) aggregate(outputs []json.RawMessage) types.String {
+	if !f.enabled || f.aggregation == "" {
+		return types.StringNull()
+	}
+
+	var count int
+	var values []float64
+	for _, raw := range outputs {
+		var output interface{}
+		if err := json.Unmarshal(raw, &output); err != nil || output == nil {
+			continue
+		}
+		count++
+		switch output := output.(type) {
+		case float64:
+			values = append(values, output)
+		case string:
+			if n, err := strconv.ParseFloat(output, 64); err == nil {
+				values = append(values, n)
+			}
+		}
+	}
+
+	if f.aggregation == "count" {
+		return types.StringValue(strconv.Itoa(count))
+	}
+	if len(values) == 0 {
+		return types.StringNull()
+	}
+
+	var result float64
+	switch f.aggregation {
+	case "sum":
+		for _, v := range values {
+			result += v
+		}
+	case "average", "avg", "mean":
+		for _, v := range values {
+			result += v
+		}
+		result /= float64(len(values))
+	case "median":
+		sort.Float64s(values)
+		middle := len(values) / 2
+		result = values[middle]
+		if len(values)%2 == 0 {
+			result = (values[middle-1] + values[middle]) / 2
+		}
+	case "min":
+		result = math.Inf(1)
+		for _, v := range values {
+			result = math.Min(result, v)
+		}
+	case "max":
+		result = math.Inf(-1)
+		for _, v := range values {
+			result = math.Max(result, v)
+		}
+	default:
+		return types.StringNull()
+	}
+	return types.StringValue(f.number(result))
+}
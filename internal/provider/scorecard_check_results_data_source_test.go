@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+)
+
+func TestOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		check      dxapi.APICheck
+		outputs    []string
+		formatted  []string
+		aggregated string
+	}{
+		"output disabled": {
+			check:     dxapi.APICheck{OutputType: str("integer")},
+			outputs:   []string{`3`},
+			formatted: []string{""},
+		},
+		"number with unit and decimals": {
+			check: dxapi.APICheck{
+				OutputEnabled:       true,
+				OutputType:          str("float"),
+				OutputAggregation:   str("average"),
+				OutputCustomOptions: str(`{"unit": "days", "decimals": 1}`),
+			},
+			outputs:    []string{`2`, `"3.5"`, `null`},
+			formatted:  []string{"2.0 days", "3.5 days", ""},
+			aggregated: "2.8 days",
+		},
+		"percentage median": {
+			check:      dxapi.APICheck{OutputEnabled: true, OutputType: str("percentage"), OutputAggregation: str("median")},
+			outputs:    []string{`90`, `50`, `70`, `100`},
+			formatted:  []string{"90%", "50%", "70%", "100%"},
+			aggregated: "80%",
+		},
+		"string count": {
+			check:      dxapi.APICheck{OutputEnabled: true, OutputType: str("string"), OutputAggregation: str("count")},
+			outputs:    []string{`"v1.2"`, `"42"`, `null`},
+			formatted:  []string{"v1.2", "42", ""},
+			aggregated: "2",
+		},
+		"unknown aggregation": {
+			check:     dxapi.APICheck{OutputEnabled: true, OutputType: str("integer"), OutputAggregation: str("p95")},
+			outputs:   []string{`7`},
+			formatted: []string{"7"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			format := newOutputFormat(&testCase.check)
+
+			var outputs []json.RawMessage
+			for i, output := range testCase.outputs {
+				outputs = append(outputs, json.RawMessage(output))
+				if got := format.value(json.RawMessage(output)).ValueString(); got != testCase.formatted[i] {
+					t.Errorf("expected output %s to be formatted as %q, got %q", output, testCase.formatted[i], got)
+				}
+			}
+
+			if got := format.aggregate(outputs).ValueString(); got != testCase.aggregated {
+				t.Errorf("expected aggregated output %q, got %q", testCase.aggregated, got)
+			}
+		})
+	}
+}
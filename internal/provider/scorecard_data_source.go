@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &scorecardDataSource{}
+
+func NewScorecardDataSource() datasource.DataSource {
+	return &scorecardDataSource{}
+}
+
+// scorecardDataSource reads a scorecard that is not managed by this
+// configuration.
+type scorecardDataSource struct {
+	client *dxapi.Client
+}
+
+// scorecardDataSourceModel describes the data source data model. It has the
+// same attributes as the resource, without the provider-only settings.
+type scorecardDataSourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	EntityFilterType    types.String `tfsdk:"entity_filter_type"`
+	EvaluationFrequency types.Int64  `tfsdk:"evaluation_frequency_hours"`
+
+	EmptyLevelLabel types.String `tfsdk:"empty_level_label"`
+	EmptyLevelColor types.String `tfsdk:"empty_level_color"`
+	Levels          []levelModel `tfsdk:"levels"`
+
+	CheckGroups []checkGroupModel `tfsdk:"check_groups"`
+
+	Description                 types.String   `tfsdk:"description"`
+	Published                   types.Bool     `tfsdk:"published"`
+	EntityFilterTypeIdentifiers []types.String `tfsdk:"entity_filter_type_identifiers"`
+	EntityFilterSql             sqlStringValue `tfsdk:"entity_filter_sql"`
+	Checks                      []checkModel   `tfsdk:"checks"`
+
+	Url             types.String `tfsdk:"url"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	LastEvaluatedAt types.String `tfsdk:"last_evaluated_at"`
+	CheckCount      types.Int64  `tfsdk:"check_count"`
+	MaxPoints       types.Int64  `tfsdk:"max_points"`
+	LevelCount      types.Int64  `tfsdk:"level_count"`
+}
+
+func (d *scorecardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard"
+}
+
+func (d *scorecardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *scorecardDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a DX Scorecard by ID or by name. Level and check group keys are not stored in DX, so they are derived from the names, the same way as when a scorecard is imported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the scorecard. Exactly one of 'id' and 'name' must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The exact name of the scorecard. Looking up a name that more than one scorecard has is an error.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of scorecard, 'LEVEL' or 'POINTS'.",
+			},
+			"entity_filter_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The filtering strategy when deciding what entities this scorecard should assess, 'entity_types' or 'sql'.",
+			},
+			"evaluation_frequency_hours": schema.Int64Attribute{
+				Computed:    true,
+				Description: "How often the scorecard is evaluated (in hours).",
+			},
+			"empty_level_label": schema.StringAttribute{
+				Computed:    true,
+				Description: "The label to display when an entity has not achieved any levels in the scorecard (levels scorecards only).",
+			},
+			"empty_level_color": schema.StringAttribute{
+				Computed:    true,
+				Description: "The color hex code to display when an entity has not achieved any levels in the scorecard (levels scorecards only).",
+			},
+			"levels": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The levels that can be achieved in this scorecard (levels scorecards only).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceLevelAttributes(),
+				},
+			},
+			"check_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Groups of checks, to help organize the scorecard for entity owners (points scorecards only).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceCheckGroupAttributes(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the scorecard.",
+			},
+			"published": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the scorecard is published.",
+			},
+			"entity_filter_type_identifiers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of entity type identifiers that the scorecard runs against.",
+			},
+			"entity_filter_sql": schema.StringAttribute{
+				CustomType:  sqlStringType{},
+				Computed:    true,
+				Description: "Custom SQL used to filter entities that the scorecard runs against.",
+			},
+			"checks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of checks that are applied to entities in the scorecard.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceCheckAttributes(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the scorecard in the DX web app.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scorecard was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scorecard was last updated.",
+			},
+			"last_evaluated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When DX last evaluated the scorecard.",
+			},
+			"check_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of checks in the scorecard.",
+			},
+			"max_points": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total points of the checks of a POINTS scorecard.",
+			},
+			"level_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of levels of a LEVEL scorecard.",
+			},
+		},
+	}
+}
+
+// dataSourceLevelAttributes returns the attributes of a level, as read by the
+// data sources.
+func dataSourceLevelAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key":   schema.StringAttribute{Computed: true},
+		"id":    schema.StringAttribute{Computed: true},
+		"name":  schema.StringAttribute{Computed: true},
+		"color": schema.StringAttribute{Computed: true},
+		"rank":  schema.Int64Attribute{Computed: true},
+	}
+}
+
+// dataSourceCheckGroupAttributes returns the attributes of a check group, as
+// read by the data sources.
+func dataSourceCheckGroupAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key":      schema.StringAttribute{Computed: true},
+		"id":       schema.StringAttribute{Computed: true},
+		"name":     schema.StringAttribute{Computed: true},
+		"ordering": schema.Int64Attribute{Computed: true},
+	}
+}
+
+// dataSourceCheckAttributes returns the attributes of a check, as read by the
+// data sources. They match the checks of the scorecard_scorecard resource.
+func dataSourceCheckAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":                    schema.StringAttribute{Computed: true},
+		"name":                  schema.StringAttribute{Computed: true},
+		"description":           schema.StringAttribute{Computed: true},
+		"ordering":              schema.Int64Attribute{Computed: true},
+		"sql":                   schema.StringAttribute{CustomType: sqlStringType{}, Computed: true},
+		"filter_sql":            schema.StringAttribute{CustomType: sqlStringType{}, Computed: true},
+		"filter_message":        schema.StringAttribute{Computed: true},
+		"output_enabled":        schema.BoolAttribute{Computed: true},
+		"output_type":           schema.StringAttribute{Computed: true},
+		"output_aggregation":    schema.StringAttribute{Computed: true},
+		"output_custom_options": schema.StringAttribute{CustomType: jsonStringType{}, Computed: true},
+		"estimated_dev_days":    schema.Int64Attribute{Computed: true},
+		"external_url":          schema.StringAttribute{Computed: true},
+		"published":             schema.BoolAttribute{Computed: true},
+
+		// Fields for level-based scorecards
+		"scorecard_level_key": schema.StringAttribute{Computed: true},
+		"level": schema.SingleNestedAttribute{
+			Computed:   true,
+			Attributes: dataSourceLevelAttributes(),
+		},
+
+		// Fields for points-based scorecards
+		"scorecard_check_group_key": schema.StringAttribute{Computed: true},
+		"check_group": schema.SingleNestedAttribute{
+			Computed:   true,
+			Attributes: dataSourceCheckGroupAttributes(),
+		},
+		"points": schema.Int64Attribute{Computed: true},
+	}
+}
+
+func (d *scorecardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config scorecardDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.Id.ValueString()
+	if id == "" {
+		var err error
+		id, err = findScorecardIdByName(ctx, d.client, config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Error finding scorecard", err.Error())
+			return
+		}
+	}
+
+	apiResp, err := d.client.GetScorecard(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading scorecard",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", id, err.Error()),
+		)
+		return
+	}
+
+	data := scorecardDataFromAPI(apiResp)
+	data.Url = stringOrNull(d.client.ScorecardURL(data.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// scorecardDataFromAPI maps a scorecard to the data source model, the same way
+// the resource maps a freshly imported scorecard. The level and check group of
+// each check are filled in as well.
+func scorecardDataFromAPI(apiResp *dxapi.APIResponse) scorecardDataSourceModel {
+	var prior scorecardModel
+	for _, chk := range apiResp.Scorecard.Checks {
+		check := checkModel{
+			ScorecardLevelKey:      levelKeyFor(chk.Level, nil),
+			ScorecardCheckGroupKey: checkGroupKeyFor(chk.CheckGroup, nil),
+		}
+		if chk.Level != nil {
+			check.Level = &levelModel{Key: check.ScorecardLevelKey}
+		}
+		if chk.CheckGroup != nil {
+			check.CheckGroup = &checkGroupModel{Key: check.ScorecardCheckGroupKey}
+		}
+		prior.Checks = append(prior.Checks, check)
+	}
+
+	var model scorecardModel
+	mapApiResponseToTerraformModel(apiResp, &model, &prior)
+
+	// The resource leaves an unset published null when DX reports false or
+	// omits it, but the data source has no configuration to follow, so
+	// published is always set below.
+	return scorecardDataSourceModel{
+		Id:                          model.Id,
+		Name:                        model.Name,
+		Type:                        model.Type,
+		EntityFilterType:            model.EntityFilterType,
+		EvaluationFrequency:         model.EvaluationFrequency,
+		EmptyLevelLabel:             model.EmptyLevelLabel,
+		EmptyLevelColor:             model.EmptyLevelColor,
+		Levels:                      model.Levels,
+		CheckGroups:                 model.CheckGroups,
+		Description:                 model.Description,
+		Published:                   types.BoolValue(apiResp.Scorecard.Published),
+		EntityFilterTypeIdentifiers: model.EntityFilterTypeIdentifiers,
+		EntityFilterSql:             model.EntityFilterSql,
+		Checks:                      model.Checks,
+		CreatedAt:                   model.CreatedAt,
+		UpdatedAt:                   model.UpdatedAt,
+		LastEvaluatedAt:             model.LastEvaluatedAt,
+		CheckCount:                  model.CheckCount,
+		MaxPoints:                   model.MaxPoints,
+		LevelCount:                  model.LevelCount,
+	}
+}
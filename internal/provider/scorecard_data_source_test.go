@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+)
+
+func TestScorecardDataFromAPI(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+	apiResp := &dxapi.APIResponse{
+		Scorecard: dxapi.APIScorecard{
+			Id:   "sc1",
+			Name: "Security",
+			Type: "POINTS",
+			CheckGroups: []*dxapi.APICheckGroup{
+				{Id: str("g1"), Name: str("Secret Scanning"), Ordering: num(0)},
+			},
+			Checks: []*dxapi.APICheck{
+				{
+					Id:         str("c1"),
+					Name:       str("No leaked keys"),
+					Points:     num(10),
+					CheckGroup: &dxapi.APICheckGroup{Id: str("g1"), Name: str("Secret Scanning"), Ordering: num(0)},
+				},
+			},
+		},
+	}
+
+	data := scorecardDataFromAPI(apiResp)
+
+	if got := data.CheckGroups[0].Key.ValueString(); got != "secret-scanning" {
+		t.Errorf("expected the check group key to be derived from its name, got %q", got)
+	}
+	check := data.Checks[0]
+	if got := check.ScorecardCheckGroupKey.ValueString(); got != "secret-scanning" {
+		t.Errorf("expected the check to reference its check group by key, got %q", got)
+	}
+	if check.CheckGroup == nil || check.CheckGroup.Id.ValueString() != "g1" {
+		t.Errorf("expected the check group of the check to be filled in, got %+v", check.CheckGroup)
+	}
+	if check.Level != nil {
+		t.Errorf("expected no level for a check of a points scorecard, got %+v", check.Level)
+	}
+	if data.Published.IsNull() || data.Published.ValueBool() {
+		t.Errorf("expected published to be false when DX omits it, got %s", data.Published)
+	}
+	if got := data.MaxPoints.ValueInt64(); got != 10 {
+		t.Errorf("expected max points 10, got %d", got)
+	}
+}
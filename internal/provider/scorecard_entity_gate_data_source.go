@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &scorecardEntityGateDataSource{}
+
+func NewScorecardEntityGateDataSource() datasource.DataSource {
+	return &scorecardEntityGateDataSource{}
+}
+
+// scorecardEntityGateDataSource checks whether an entity meets a minimum level
+// or points total on a scorecard.
+type scorecardEntityGateDataSource struct {
+	client *dxapi.Client
+}
+
+// scorecardEntityGateDataSourceModel describes the data source data model.
+type scorecardEntityGateDataSourceModel struct {
+	ScorecardId      types.String `tfsdk:"scorecard_id"`
+	EntityIdentifier types.String `tfsdk:"entity_identifier"`
+	MinimumLevelKey  types.String `tfsdk:"minimum_level_key"`
+	MinimumPoints    types.Int64  `tfsdk:"minimum_points"`
+	ErrorOnFailure   types.Bool   `tfsdk:"error_on_failure"`
+
+	Passed        types.Bool          `tfsdk:"passed"`
+	LevelKey      types.String        `tfsdk:"level_key"`
+	LevelName     types.String        `tfsdk:"level_name"`
+	Points        types.Int64         `tfsdk:"points"`
+	EvaluatedAt   types.String        `tfsdk:"evaluated_at"`
+	FailingChecks []failingCheckModel `tfsdk:"failing_checks"`
+}
+
+// failingCheckModel is a check that keeps an entity below the bar of a gate.
+type failingCheckModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ExternalUrl types.String `tfsdk:"external_url"`
+}
+
+func (d *scorecardEntityGateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_gate"
+}
+
+func (d *scorecardEntityGateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *scorecardEntityGateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether an entity meets a minimum level or points total on a DX Scorecard, e.g. to gate deployments on production readiness.",
+		Attributes: map[string]schema.Attribute{
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scorecard.",
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required:    true,
+				Description: "The identifier of the entity.",
+			},
+			"minimum_level_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the level the entity must have achieved at least (levels scorecards only), as in scorecard_level. DX does not store level keys, so it is derived from the level name, e.g. 'silver-tier' for 'Silver Tier'. Exactly one of 'minimum_level_key' and 'minimum_points' must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("minimum_points")),
+				},
+			},
+			"minimum_points": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The points the entity must have earned at least (points scorecards only).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"error_on_failure": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, reading the data source fails with an error listing the failing checks and their remediation links if the entity is below the bar, which stops the plan. Defaults to false.",
+			},
+			"passed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the entity meets the minimum level or points.",
+			},
+			"level_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key of the level the entity achieved (levels scorecards only). Null if the entity has not achieved any level.",
+			},
+			"level_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the level the entity achieved (levels scorecards only). Null if the entity has not achieved any level.",
+			},
+			"points": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The points the entity earned (points scorecards only).",
+			},
+			"evaluated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When DX last evaluated the scorecard for the entity.",
+			},
+			"failing_checks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The checks the entity fails that count towards the bar: for levels scorecards, the checks of the minimum level and the levels below it; for points scorecards, every failing check.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the check.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the check.",
+						},
+						"external_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Link to documentation on how to make the check pass.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *scorecardEntityGateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scorecardEntityGateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := data.ScorecardId.ValueString()
+	entity := data.EntityIdentifier.ValueString()
+
+	apiResp, err := d.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading scorecard",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+
+	scores, err := d.client.ListEntityScores(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading entity scores",
+			fmt.Sprintf("Could not read the entity scores of scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+	var score *dxapi.APIEntityScore
+	for i := range scores {
+		if scores[i].EntityIdentifier == entity {
+			score = &scores[i]
+			break
+		}
+	}
+	if score == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entity_identifier"),
+			"No score for entity",
+			fmt.Sprintf("Scorecard %q (ID %s) has no results for entity %q. The entity may not exist, may not be evaluated by the scorecard, or may not have been evaluated yet.", apiResp.Scorecard.Name, scorecardId, entity),
+		)
+		return
+	}
+
+	gate := entityGate{minimumLevelKey: data.MinimumLevelKey, minimumPoints: data.MinimumPoints}
+	passed, failing, err := gate.evaluate(&apiResp.Scorecard, score)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot evaluate gate", err.Error())
+		return
+	}
+
+	model := entityScoreModelFromAPI(score)
+	data.Passed = types.BoolValue(passed)
+	data.LevelKey = model.LevelKey
+	data.LevelName = model.LevelName
+	data.Points = model.Points
+	data.EvaluatedAt = model.EvaluatedAt
+	data.FailingChecks = failing
+
+	if !passed && data.ErrorOnFailure.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Entity is below the required bar",
+			gate.failureDetail(apiResp.Scorecard.Name, entity, model, failing),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// entityGate is the bar an entity has to meet on a scorecard.
+type entityGate struct {
+	minimumLevelKey types.String
+	minimumPoints   types.Int64
+}
+
+// evaluate reports whether the entity meets the bar, and which of its failing
+// checks count towards it.
+func (g entityGate) evaluate(scorecard *dxapi.APIScorecard, score *dxapi.APIEntityScore) (bool, []failingCheckModel, error) {
+	failingIds := make(map[string]bool, len(score.FailingCheckIds))
+	for _, id := range score.FailingCheckIds {
+		failingIds[id] = true
+	}
+
+	switch scorecard.Type {
+	case "LEVEL":
+		if g.minimumLevelKey.IsNull() {
+			return false, nil, fmt.Errorf("scorecard %q is a levels scorecard, set minimum_level_key instead of minimum_points", scorecard.Name)
+		}
+		minimum, err := findAPILevelByKey(scorecard, g.minimumLevelKey.ValueString())
+		if err != nil {
+			return false, nil, err
+		}
+
+		required := 0
+		if minimum.Rank != nil {
+			required = *minimum.Rank
+		}
+		achieved := levelRank(scorecard, score.Level)
+
+		// Checks of higher levels do not keep the entity below the bar.
+		failing := []failingCheckModel{}
+		for _, chk := range scorecard.Checks {
+			if chk.Id == nil || !failingIds[*chk.Id] {
+				continue
+			}
+			if levelRank(scorecard, chk.Level) > required {
+				continue
+			}
+			failing = append(failing, failingCheckFromAPI(chk))
+		}
+		return achieved >= required, failing, nil
+
+	case "POINTS":
+		if g.minimumPoints.IsNull() {
+			return false, nil, fmt.Errorf("scorecard %q is a points scorecard, set minimum_points instead of minimum_level_key", scorecard.Name)
+		}
+
+		failing := []failingCheckModel{}
+		for _, chk := range scorecard.Checks {
+			if chk.Id != nil && failingIds[*chk.Id] {
+				failing = append(failing, failingCheckFromAPI(chk))
+			}
+		}
+		points := int64(0)
+		if score.Points != nil {
+			points = int64(*score.Points)
+		}
+		return points >= g.minimumPoints.ValueInt64(), failing, nil
+
+	default:
+		return false, nil, fmt.Errorf("unsupported scorecard type: %s", scorecard.Type)
+	}
+}
+
+// failureDetail describes why an entity is below the bar, with the links to
+// fix its failing checks.
+func (g entityGate) failureDetail(scorecardName, entity string, score entityScoreModel, failing []failingCheckModel) string {
+	var detail string
+	if !g.minimumLevelKey.IsNull() {
+		achieved := "no level"
+		if !score.LevelName.IsNull() {
+			achieved = fmt.Sprintf("level %q", score.LevelName.ValueString())
+		}
+		detail = fmt.Sprintf("Entity %q has achieved %s on scorecard %q, but at least level %q is required.",
+			entity, achieved, scorecardName, g.minimumLevelKey.ValueString())
+	} else {
+		detail = fmt.Sprintf("Entity %q has %d points on scorecard %q, but at least %d are required.",
+			entity, score.Points.ValueInt64(), scorecardName, g.minimumPoints.ValueInt64())
+	}
+
+	if len(failing) == 0 {
+		return detail
+	}
+	lines := make([]string, 0, len(failing))
+	for _, check := range failing {
+		line := fmt.Sprintf("  - %s", check.Name.ValueString())
+		if check.ExternalUrl.ValueString() != "" {
+			line += ": " + check.ExternalUrl.ValueString()
+		}
+		lines = append(lines, line)
+	}
+	return detail + " Fix these failing checks:\n\n" + strings.Join(lines, "\n")
+}
+
+// levelRank returns the rank of a level referenced by a check or an entity
+// score, looked up in the levels of the scorecard since references may omit
+// it. A missing level ranks 0, below every level.
+func levelRank(scorecard *dxapi.APIScorecard, ref *dxapi.APILevel) int {
+	if ref == nil {
+		return 0
+	}
+	for _, level := range scorecard.Levels {
+		if ref.Id != nil && level.Id != nil && *ref.Id == *level.Id && level.Rank != nil {
+			return *level.Rank
+		}
+	}
+	if ref.Rank != nil {
+		return *ref.Rank
+	}
+	return 0
+}
+
+func failingCheckFromAPI(chk *dxapi.APICheck) failingCheckModel {
+	return failingCheckModel{
+		Id:          stringOrNull(chk.Id),
+		Name:        stringOrNull(chk.Name),
+		ExternalUrl: stringOrNull(chk.ExternalUrl),
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEntityGateEvaluate(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	rank := func(n int) *int { return &n }
+	bronze := &dxapi.APILevel{Id: str("l1"), Name: str("Bronze"), Rank: rank(1)}
+	silver := &dxapi.APILevel{Id: str("l2"), Name: str("Silver"), Rank: rank(2)}
+	gold := &dxapi.APILevel{Id: str("l3"), Name: str("Gold"), Rank: rank(3)}
+	levels := &dxapi.APIScorecard{
+		Name:   "Production Readiness",
+		Type:   "LEVEL",
+		Levels: []*dxapi.APILevel{bronze, silver, gold},
+		Checks: []*dxapi.APICheck{
+			{Id: str("c1"), Name: str("Has owner"), Level: &dxapi.APILevel{Id: str("l1")}},
+			{Id: str("c2"), Name: str("Has runbook"), ExternalUrl: str("https://wiki/runbooks"), Level: &dxapi.APILevel{Id: str("l2")}},
+			{Id: str("c3"), Name: str("Has SLOs"), Level: &dxapi.APILevel{Id: str("l3")}},
+		},
+	}
+	silverGate := entityGate{minimumLevelKey: types.StringValue("silver"), minimumPoints: types.Int64Null()}
+
+	passed, failing, err := silverGate.evaluate(levels, &dxapi.APIEntityScore{
+		Level:           &dxapi.APILevel{Id: str("l1"), Name: str("Bronze")},
+		FailingCheckIds: []string{"c2", "c3"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if passed {
+		t.Error("expected a bronze entity to fail a silver gate")
+	}
+	if len(failing) != 1 || failing[0].Id.ValueString() != "c2" || failing[0].ExternalUrl.ValueString() != "https://wiki/runbooks" {
+		t.Errorf("expected only the silver check to block the gate, got %v", failing)
+	}
+
+	passed, _, err = silverGate.evaluate(levels, &dxapi.APIEntityScore{Level: gold, FailingCheckIds: []string{}})
+	if err != nil || !passed {
+		t.Errorf("expected a gold entity to pass a silver gate, got passed=%t err=%v", passed, err)
+	}
+
+	passed, _, err = silverGate.evaluate(levels, &dxapi.APIEntityScore{})
+	if err != nil || passed {
+		t.Errorf("expected an entity without a level to fail, got passed=%t err=%v", passed, err)
+	}
+
+	unknownLevel := entityGate{minimumLevelKey: types.StringValue("platinum"), minimumPoints: types.Int64Null()}
+	if _, _, err := unknownLevel.evaluate(levels, &dxapi.APIEntityScore{}); err == nil || !strings.Contains(err.Error(), "bronze, gold, silver") {
+		t.Errorf("expected an error listing the level keys, got %v", err)
+	}
+
+	pointsGate := entityGate{minimumLevelKey: types.StringNull(), minimumPoints: types.Int64Value(10)}
+	if _, _, err := pointsGate.evaluate(levels, &dxapi.APIEntityScore{}); err == nil {
+		t.Error("expected an error for minimum_points on a levels scorecard")
+	}
+
+	points := &dxapi.APIScorecard{
+		Name:   "Hygiene",
+		Type:   "POINTS",
+		Checks: []*dxapi.APICheck{{Id: str("p1"), Name: str("Has README")}, {Id: str("p2"), Name: str("Has CI")}},
+	}
+	passed, failing, err = pointsGate.evaluate(points, &dxapi.APIEntityScore{Points: rank(8), FailingCheckIds: []string{"p2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if passed || len(failing) != 1 || failing[0].Name.ValueString() != "Has CI" {
+		t.Errorf("expected 8 points to fail with Has CI failing, got passed=%t failing=%v", passed, failing)
+	}
+	passed, _, _ = pointsGate.evaluate(points, &dxapi.APIEntityScore{Points: rank(10)})
+	if !passed {
+		t.Error("expected exactly the minimum points to pass")
+	}
+
+	detail := silverGate.failureDetail("Production Readiness", "checkout",
+		entityScoreModelFromAPI(&dxapi.APIEntityScore{Level: bronze}),
+		[]failingCheckModel{failingCheckFromAPI(levels.Checks[1])})
+	if !strings.Contains(detail, `level "Bronze"`) || !strings.Contains(detail, "- Has runbook: https://wiki/runbooks") {
+		t.Errorf("unexpected failure detail: %s", detail)
+	}
+}
+
+func TestEntityGateLevelKey(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	rank := func(n int) *int { return &n }
+	// DX does not store level keys, so minimum_level_key is matched against
+	// the key derived from each level name.
+	levels := &dxapi.APIScorecard{
+		Name: "Production Readiness",
+		Type: "LEVEL",
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Name: str("Silver"), Rank: rank(1)},
+			{Id: str("l2"), Name: str("Silver Tier"), Rank: rank(2)},
+			{Id: str("l3"), Name: str("Gold"), Rank: rank(3)},
+			{Id: str("l4"), Name: str("gold"), Rank: rank(4)},
+		},
+	}
+	score := &dxapi.APIEntityScore{Level: &dxapi.APILevel{Id: str("l1"), Name: str("Silver")}}
+
+	testCases := map[string]struct {
+		key          string
+		expectPassed bool
+		expectError  bool
+	}{
+		"derived key": {
+			key: "silver-tier",
+		},
+		"key of another level": {
+			key:          "silver",
+			expectPassed: true,
+		},
+		"name": {
+			key:         "Silver Tier",
+			expectError: true,
+		},
+		"shared key": {
+			key:         "gold",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gate := entityGate{minimumLevelKey: types.StringValue(testCase.key), minimumPoints: types.Int64Null()}
+			passed, _, err := gate.evaluate(levels, score)
+			if (err != nil) != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, err)
+			}
+			if passed != testCase.expectPassed {
+				t.Errorf("expected passed %t, got %t", testCase.expectPassed, passed)
+			}
+		})
+	}
+}
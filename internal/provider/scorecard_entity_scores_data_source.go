@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &scorecardEntityScoresDataSource{}
+
+func NewScorecardEntityScoresDataSource() datasource.DataSource {
+	return &scorecardEntityScoresDataSource{}
+}
+
+// scorecardEntityScoresDataSource reads the current results of a scorecard.
+type scorecardEntityScoresDataSource struct {
+	client *dxapi.Client
+}
+
+// scorecardEntityScoresDataSourceModel describes the data source data model.
+type scorecardEntityScoresDataSourceModel struct {
+	ScorecardId       types.String       `tfsdk:"scorecard_id"`
+	EntityIdentifiers []types.String     `tfsdk:"entity_identifiers"`
+	EntityScores      []entityScoreModel `tfsdk:"entity_scores"`
+}
+
+// entityScoreModel is the result of a scorecard for one entity.
+type entityScoreModel struct {
+	EntityIdentifier types.String   `tfsdk:"entity_identifier"`
+	EntityName       types.String   `tfsdk:"entity_name"`
+	LevelKey         types.String   `tfsdk:"level_key"`
+	LevelName        types.String   `tfsdk:"level_name"`
+	LevelRank        types.Int64    `tfsdk:"level_rank"`
+	Points           types.Int64    `tfsdk:"points"`
+	PassingCheckIds  []types.String `tfsdk:"passing_check_ids"`
+	FailingCheckIds  []types.String `tfsdk:"failing_check_ids"`
+	EvaluatedAt      types.String   `tfsdk:"evaluated_at"`
+}
+
+func (d *scorecardEntityScoresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_scores"
+}
+
+func (d *scorecardEntityScoresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *scorecardEntityScoresDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current results of a DX Scorecard for the entities it evaluates.",
+		Attributes: map[string]schema.Attribute{
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scorecard.",
+			},
+			"entity_identifiers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only read the results of these entities. Identifiers that the scorecard has no results for are reported as a warning.",
+			},
+			"entity_scores": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The results of the scorecard, one per entity.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity_identifier": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier of the entity.",
+						},
+						"entity_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the entity.",
+						},
+						"level_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the level the entity achieved, derived from the level name unless DX returns one (levels scorecards only). Null if the entity has not achieved any level.",
+						},
+						"level_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the level the entity achieved (levels scorecards only). Null if the entity has not achieved any level.",
+						},
+						"level_rank": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The rank of the level the entity achieved (levels scorecards only). Null if the entity has not achieved any level.",
+						},
+						"points": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The points the entity earned (points scorecards only).",
+						},
+						"passing_check_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the checks the entity passes.",
+						},
+						"failing_check_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the checks the entity fails.",
+						},
+						"evaluated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When DX last evaluated the scorecard for the entity.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *scorecardEntityScoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scorecardEntityScoresDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := data.ScorecardId.ValueString()
+	scores, err := d.client.ListEntityScores(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading entity scores",
+			fmt.Sprintf("Could not read the entity scores of scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+
+	var identifiers []string
+	if data.EntityIdentifiers != nil {
+		identifiers = make([]string, 0, len(data.EntityIdentifiers))
+		for _, identifier := range data.EntityIdentifiers {
+			identifiers = append(identifiers, identifier.ValueString())
+		}
+	}
+
+	var missing []string
+	data.EntityScores, missing = entityScoreModels(scores, identifiers)
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("entity_identifiers"),
+			"No scores for some entities",
+			fmt.Sprintf("Scorecard ID %s has no results for: %s. The entities may not exist, may not be evaluated by the scorecard, or may not have been evaluated yet.", scorecardId, strings.Join(missing, ", ")),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// entityScoreModels maps entity scores to the data source model. When
+// identifiers is not nil, only the scores of those entities are kept, in the
+// order they are listed, and the identifiers without a score are returned.
+func entityScoreModels(scores []dxapi.APIEntityScore, identifiers []string) ([]entityScoreModel, []string) {
+	models := []entityScoreModel{}
+	if identifiers == nil {
+		for i := range scores {
+			models = append(models, entityScoreModelFromAPI(&scores[i]))
+		}
+		return models, nil
+	}
+
+	byIdentifier := make(map[string]*dxapi.APIEntityScore, len(scores))
+	for i := range scores {
+		byIdentifier[scores[i].EntityIdentifier] = &scores[i]
+	}
+
+	var missing []string
+	for _, identifier := range identifiers {
+		score, ok := byIdentifier[identifier]
+		if !ok {
+			missing = append(missing, identifier)
+			continue
+		}
+		models = append(models, entityScoreModelFromAPI(score))
+	}
+	return models, missing
+}
+
+func entityScoreModelFromAPI(score *dxapi.APIEntityScore) entityScoreModel {
+	model := entityScoreModel{
+		EntityIdentifier: types.StringValue(score.EntityIdentifier),
+		EntityName:       stringOrNull(score.EntityName),
+		LevelKey:         types.StringNull(),
+		LevelName:        types.StringNull(),
+		LevelRank:        types.Int64Null(),
+		Points:           int64OrNull(score.Points),
+		PassingCheckIds:  stringValues(score.PassingCheckIds),
+		FailingCheckIds:  stringValues(score.FailingCheckIds),
+		EvaluatedAt:      stringOrNull(score.EvaluatedAt),
+	}
+	if score.Level != nil {
+		model.LevelKey = types.StringValue(remoteLevelKey(score.Level))
+		model.LevelName = stringOrNull(score.Level.Name)
+		model.LevelRank = int64OrNull(score.Level.Rank)
+	}
+	return model
+}
+
+// stringValues converts strings to a list value that is empty rather than null
+// when there are none.
+func stringValues(values []string) []types.String {
+	list := make([]types.String, 0, len(values))
+	for _, value := range values {
+		list = append(list, types.StringValue(value))
+	}
+	return list
+}
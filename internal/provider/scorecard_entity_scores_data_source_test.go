@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+)
+
+func TestEntityScoreModels(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	rank := func(n int) *int { return &n }
+	scores := []dxapi.APIEntityScore{
+		{
+			EntityIdentifier: "checkout",
+			Level:            &dxapi.APILevel{Id: str("l2"), Name: str("Gold Tier"), Rank: rank(3)},
+			PassingCheckIds:  []string{"c1", "c2"},
+			EvaluatedAt:      str("2026-10-01T12:00:00Z"),
+		},
+		{
+			EntityIdentifier: "payments",
+			FailingCheckIds:  []string{"c1"},
+		},
+	}
+
+	all, missing := entityScoreModels(scores, nil)
+	if len(all) != 2 || missing != nil {
+		t.Fatalf("expected every score and nothing missing, got %d scores and %v missing", len(all), missing)
+	}
+
+	checkout := all[0]
+	if checkout.LevelKey.ValueString() != "gold-tier" || checkout.LevelRank.ValueInt64() != 3 {
+		t.Errorf("expected level gold-tier with rank 3, got %s with rank %s", checkout.LevelKey, checkout.LevelRank)
+	}
+	if len(checkout.FailingCheckIds) != 0 || checkout.FailingCheckIds == nil {
+		t.Errorf("expected an empty list of failing checks, got %v", checkout.FailingCheckIds)
+	}
+
+	payments := all[1]
+	if !payments.LevelName.IsNull() || !payments.Points.IsNull() {
+		t.Errorf("expected no level and no points, got level %s and points %s", payments.LevelName, payments.Points)
+	}
+
+	filtered, missing := entityScoreModels(scores, []string{"payments", "search"})
+	if len(filtered) != 1 || filtered[0].EntityIdentifier.ValueString() != "payments" {
+		t.Errorf("expected only the payments score, got %v", filtered)
+	}
+	if !reflect.DeepEqual(missing, []string{"search"}) {
+		t.Errorf("expected search to be missing, got %v", missing)
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &scorecardLevelResource{}
+	_ resource.ResourceWithImportState    = &scorecardLevelResource{}
+	_ resource.ResourceWithValidateConfig = &scorecardLevelResource{}
+)
+
+func NewScorecardLevelResource() resource.Resource {
+	return &scorecardLevelResource{}
+}
+
+// scorecardLevelResource manages a single level of a LEVEL scorecard.
+type scorecardLevelResource struct {
+	client *dxapi.Client
+}
+
+// scorecardLevelResourceModel describes the resource data model.
+type scorecardLevelResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ScorecardId types.String `tfsdk:"scorecard_id"`
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Color       types.String `tfsdk:"color"`
+	Rank        types.Int64  `tfsdk:"rank"`
+}
+
+// payload builds the API representation of the level.
+func (m scorecardLevelResourceModel) payload() map[string]interface{} {
+	payload := map[string]interface{}{
+		"key":   m.Key.ValueString(),
+		"name":  m.Name.ValueString(),
+		"color": m.Color.ValueString(),
+		"rank":  m.Rank.ValueInt64(),
+	}
+	setIfKnown(payload, "id", m.Id)
+	return payload
+}
+
+// setFromAPI updates the model from a level returned by the API. The key is
+// only replaced when DX returns one.
+func (m *scorecardLevelResourceModel) setFromAPI(level *dxapi.APILevel) {
+	m.Id = stringOrNull(level.Id)
+	m.Name = stringOrNull(level.Name)
+	m.Color = stringOrNull(level.Color)
+	m.Rank = int64OrNull(level.Rank)
+	if level.Key != nil && *level.Key != "" {
+		m.Key = types.StringValue(*level.Key)
+	}
+}
+
+func (r *scorecardLevelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_level"
+}
+
+func (r *scorecardLevelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.client
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (r *scorecardLevelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single level of a DX levels scorecard. The scorecard_scorecard resource that owns the scorecard must not configure 'levels', or it removes levels managed by this resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the level.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the levels scorecard the level belongs to. Changing it forces a new level.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The key of the level, which scorecard_check resources reference it by. DX does not store level keys, so the key is derived from the name, e.g. 'gold-tier' for 'Gold Tier', and a configured key must match it.",
+				PlanModifiers: []planmodifier.String{
+					keyFromName(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the level.",
+			},
+			"color": schema.StringAttribute{
+				Required:    true,
+				Description: "The color hex code of the level.",
+			},
+			"rank": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The rank of the level. Defaults to ranking the level above the existing levels of the scorecard.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *scorecardLevelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scorecardLevelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDerivedKey(path.Root("key"), config.Key, config.Name)...)
+}
+
+func (r *scorecardLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scorecardLevelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := plan.ScorecardId.ValueString()
+	existing := map[string]bool{}
+
+	apiResp, err := modifyScorecard(ctx, r.client, scorecardId, func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		for _, level := range scorecard.Levels {
+			if level.Id != nil {
+				existing[*level.Id] = true
+			}
+		}
+		return levelCreatePayload(scorecard, plan)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating level", err.Error())
+		return
+	}
+
+	// The new level is the one that did not exist before.
+	var created *dxapi.APILevel
+	for _, level := range apiResp.Scorecard.Levels {
+		if level.Id != nil && !existing[*level.Id] {
+			created = level
+			break
+		}
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating level", fmt.Sprintf("Scorecard %s was updated, but the API response does not contain the new level.", scorecardId))
+		return
+	}
+
+	plan.setFromAPI(created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scorecardLevelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scorecardLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := state.ScorecardId.ValueString()
+	apiResp, err := r.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading level",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+
+	level := findAPILevel(&apiResp.Scorecard, state.Id.ValueString())
+	if level == nil {
+		// The level was removed outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromAPI(level)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *scorecardLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scorecardLevelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state scorecardLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// DX does not store level keys, so a new key alone only needs to be
+	// saved in state.
+	if plan.Name.Equal(state.Name) && plan.Color.Equal(state.Color) && plan.Rank.Equal(state.Rank) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	id := plan.Id.ValueString()
+	scorecardId := plan.ScorecardId.ValueString()
+
+	apiResp, err := modifyScorecard(ctx, r.client, scorecardId, func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		if err := validateLevelScorecard(scorecard); err != nil {
+			return nil, err
+		}
+
+		_, levels, _ := scorecardReferences(scorecard)
+		replaced := false
+		for i, ref := range levels {
+			if ref["id"] == id {
+				levels[i] = plan.payload()
+				replaced = true
+			}
+		}
+		if !replaced {
+			return nil, fmt.Errorf("level %s no longer exists in scorecard %q", id, scorecard.Name)
+		}
+
+		return map[string]interface{}{"levels": levels}, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating level", err.Error())
+		return
+	}
+
+	level := findAPILevel(&apiResp.Scorecard, id)
+	if level == nil {
+		resp.Diagnostics.AddError("Error updating level", fmt.Sprintf("Scorecard %s was updated, but the API response does not contain level %s.", scorecardId, id))
+		return
+	}
+
+	plan.setFromAPI(level)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scorecardLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scorecardLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueString()
+	_, err := modifyScorecard(ctx, r.client, state.ScorecardId.ValueString(), func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error) {
+		_, levels, _ := scorecardReferences(scorecard)
+
+		// Leaving the level out of the list removes it.
+		remaining := make([]map[string]interface{}, 0, len(levels))
+		for _, ref := range levels {
+			if ref["id"] != id {
+				remaining = append(remaining, ref)
+			}
+		}
+
+		return map[string]interface{}{"levels": remaining}, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting level", err.Error())
+	}
+}
+
+// ImportState imports a level by "<scorecard_id>/<level_key>", where the key
+// is derived from the level name, or by "<scorecard_id>/<level_id>".
+func (r *scorecardLevelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scorecardId, ref, ok := strings.Cut(req.ID, "/")
+	if !ok || scorecardId == "" || ref == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form \"<scorecard_id>/<level_key>\", got: %q", req.ID),
+		)
+		return
+	}
+
+	apiResp, err := r.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing level",
+			fmt.Sprintf("Could not read scorecard ID %s: %s", scorecardId, err.Error()),
+		)
+		return
+	}
+	level, err := findImportedLevel(&apiResp.Scorecard, ref)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing level", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scorecard_id"), scorecardId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), level.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), remoteLevelKey(level))...)
+}
+
+// findImportedLevel returns the level an import ID refers to by key, or else
+// by id.
+func findImportedLevel(scorecard *dxapi.APIScorecard, ref string) (*dxapi.APILevel, error) {
+	level, err := findAPILevelByKey(scorecard, ref)
+	if err == nil {
+		return level, nil
+	}
+	if level := findAPILevel(scorecard, ref); level != nil {
+		return level, nil
+	}
+	return nil, err
+}
+
+// levelCreatePayload builds the update that adds the planned level to the
+// scorecard. Level names must be unique, and so must the keys derived from
+// them, since levels are looked up by key.
+func levelCreatePayload(scorecard *dxapi.APIScorecard, plan scorecardLevelResourceModel) (map[string]interface{}, error) {
+	if err := validateLevelScorecard(scorecard); err != nil {
+		return nil, err
+	}
+	if _, err := findAPILevelByName(scorecard, plan.Name.ValueString()); err == nil {
+		return nil, fmt.Errorf("scorecard %q already has a level named %q", scorecard.Name, plan.Name.ValueString())
+	}
+	if _, err := findAPILevelByKey(scorecard, plan.Key.ValueString()); err == nil {
+		return nil, fmt.Errorf("scorecard %q already has a level with key %q", scorecard.Name, plan.Key.ValueString())
+	}
+
+	level := plan
+	if level.Rank.IsUnknown() {
+		level.Rank = types.Int64Value(nextLevelRank(scorecard))
+	}
+	level.Id = types.StringNull()
+
+	_, levels, _ := scorecardReferences(scorecard)
+	levels = append(levels, level.payload())
+	return map[string]interface{}{"levels": levels}, nil
+}
+
+// validateLevelScorecard reports an error unless the scorecard has levels.
+func validateLevelScorecard(scorecard *dxapi.APIScorecard) error {
+	if scorecard.Type != "LEVEL" {
+		return fmt.Errorf("scorecard %q is a %s scorecard, levels can only be added to LEVEL scorecards", scorecard.Name, scorecard.Type)
+	}
+	return nil
+}
+
+// nextLevelRank returns the rank above the highest ranked level of the scorecard.
+func nextLevelRank(scorecard *dxapi.APIScorecard) int64 {
+	var highest int64
+	for _, level := range scorecard.Levels {
+		if level.Rank != nil && int64(*level.Rank) > highest {
+			highest = int64(*level.Rank)
+		}
+	}
+	return highest + 1
+}
+
+// findAPILevel returns the level with the id, or nil if the scorecard has no
+// such level.
+func findAPILevel(scorecard *dxapi.APIScorecard, id string) *dxapi.APILevel {
+	for _, level := range scorecard.Levels {
+		if level.Id != nil && *level.Id == id {
+			return level
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFindAPILevel(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	rank := func(n int) *int { return &n }
+	scorecard := &dxapi.APIScorecard{
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Name: str("Bronze"), Rank: rank(1)},
+			{Id: str("l2"), Name: str("Gold Tier"), Rank: rank(3)},
+		},
+	}
+
+	if level := findAPILevel(scorecard, "l2"); level == nil || *level.Id != "l2" {
+		t.Errorf("expected level l2, got %v", level)
+	}
+	if level := findAPILevel(scorecard, "l3"); level != nil {
+		t.Errorf("expected no level for a removed id, got %v", level)
+	}
+	if level := findAPILevel(scorecard, "gold-tier"); level != nil {
+		t.Errorf("expected levels not to be found by derived key, got %v", level)
+	}
+
+	if got := nextLevelRank(scorecard); got != 4 {
+		t.Errorf("expected next rank 4, got %d", got)
+	}
+}
+
+func TestLevelCreatePayload(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	rank := func(n int) *int { return &n }
+	scorecard := &dxapi.APIScorecard{
+		Name: "Production Readiness",
+		Type: "LEVEL",
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Name: str("Gold Tier"), Rank: rank(1)},
+		},
+	}
+	level := func(key, name string) scorecardLevelResourceModel {
+		return scorecardLevelResourceModel{
+			Id:    types.StringUnknown(),
+			Key:   types.StringValue(key),
+			Name:  types.StringValue(name),
+			Color: types.StringValue("#ffd700"),
+			Rank:  types.Int64Unknown(),
+		}
+	}
+
+	payload, err := levelCreatePayload(scorecard, level("platinum", "Platinum"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []map[string]interface{}{
+		{"id": "l1", "key": "l1"},
+		{"key": "platinum", "name": "Platinum", "color": "#ffd700", "rank": int64(2)},
+	}
+	if !reflect.DeepEqual(payload["levels"], expected) {
+		t.Errorf("expected levels %v, got %v", expected, payload["levels"])
+	}
+
+	if _, err := levelCreatePayload(scorecard, level("gold-tier", "Gold Tier")); err == nil {
+		t.Error("expected an error for a duplicate level name")
+	}
+	if _, err := levelCreatePayload(scorecard, level("gold-tier", "Gold tier")); err == nil {
+		t.Error("expected an error for a duplicate level key")
+	}
+
+	// Reading the level back keeps the planned key.
+	created := level("gold-tier", "Gold Tier")
+	created.setFromAPI(&dxapi.APILevel{Id: str("l2"), Name: str("Gold Tier"), Color: str("#ffd700"), Rank: rank(2)})
+	if created.Key.ValueString() != "gold-tier" || created.Id.ValueString() != "l2" {
+		t.Errorf("expected level l2 with key gold-tier, got %s with key %s", created.Id, created.Key)
+	}
+}
+
+func TestFindImportedLevel(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	scorecard := &dxapi.APIScorecard{
+		Name: "Production Readiness",
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Name: str("Bronze")},
+			{Id: str("l2"), Name: str("Silver Tier")},
+			{Id: str("l3"), Name: str("Gold")},
+			{Id: str("l4"), Name: str("gold")},
+		},
+	}
+
+	testCases := map[string]struct {
+		ref         string
+		expectedId  string
+		expectError bool
+	}{
+		"key": {
+			ref:        "silver-tier",
+			expectedId: "l2",
+		},
+		"id": {
+			ref:        "l1",
+			expectedId: "l1",
+		},
+		"name": {
+			ref:         "Silver Tier",
+			expectError: true,
+		},
+		"unknown key": {
+			ref:         "silver",
+			expectError: true,
+		},
+		"ambiguous key": {
+			ref:         "gold",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			level, err := findImportedLevel(scorecard, testCase.ref)
+			if (err != nil) != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, err)
+			}
+			if err == nil && *level.Id != testCase.expectedId {
+				t.Errorf("expected level %s, got %s", testCase.expectedId, *level.Id)
+			}
+		})
+	}
+}
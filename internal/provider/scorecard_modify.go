@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+)
+
+// scorecardLocks serializes the read-modify-write updates that the resources
+// managing parts of a scorecard make, so parallel applies within one run do
+// not overwrite each other's changes.
+var scorecardLocks = &keyedMutex{locks: map[string]*sync.Mutex{}}
+
+// keyedMutex is a set of mutexes, one per key.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the mutex for the key and returns the function that unlocks it.
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		k.locks[key] = l
+	}
+	k.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// modifyScorecard reads a scorecard, builds an update from it and sends the
+// update, while holding the lock for the scorecard. The update payload only
+// needs to contain what changes; see scorecardReferences.
+func modifyScorecard(ctx context.Context, client *dxapi.Client, id string, modify func(scorecard *dxapi.APIScorecard) (map[string]interface{}, error)) (*dxapi.APIResponse, error) {
+	unlock := scorecardLocks.lock(id)
+	defer unlock()
+
+	current, err := client.GetScorecard(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("reading scorecard %s: %w", id, err)
+	}
+
+	payload, err := modify(&current.Scorecard)
+	if err != nil {
+		return nil, err
+	}
+	payload["id"] = id
+
+	return client.UpdateScorecard(ctx, payload)
+}
+
+// scorecardReferences returns a partial update payload that references the
+// current checks, levels and check groups of a scorecard by id, leaving them
+// unchanged. Callers replace or add the elements they modify. Levels and
+// check groups are included because DX resolves the level and check group
+// keys of checks against them.
+//
+// DX does not store keys: they only tie checks to levels and check groups
+// within one request. The keys configured in Terraform are not known here, so
+// existing levels and check groups are keyed by their id, and checks sent in
+// the same request reference them by that id.
+func scorecardReferences(scorecard *dxapi.APIScorecard) (checks, levels, groups []map[string]interface{}) {
+	checks = make([]map[string]interface{}, 0, len(scorecard.Checks))
+	for _, check := range scorecard.Checks {
+		if check.Id != nil {
+			checks = append(checks, map[string]interface{}{"id": *check.Id})
+		}
+	}
+
+	levels = make([]map[string]interface{}, 0, len(scorecard.Levels))
+	for _, level := range scorecard.Levels {
+		if level.Id != nil {
+			levels = append(levels, map[string]interface{}{"id": *level.Id, "key": *level.Id})
+		}
+	}
+
+	groups = make([]map[string]interface{}, 0, len(scorecard.CheckGroups))
+	for _, group := range scorecard.CheckGroups {
+		if group.Id != nil {
+			groups = append(groups, map[string]interface{}{"id": *group.Id, "key": *group.Id})
+		}
+	}
+
+	return checks, levels, groups
+}
+
+// setScorecardReferences adds the levels or check groups that apply to the
+// scorecard type to a payload.
+func setScorecardReferences(payload map[string]interface{}, scorecardType string, levels, groups []map[string]interface{}) {
+	switch scorecardType {
+	case "LEVEL":
+		payload["levels"] = levels
+	case "POINTS":
+		payload["check_groups"] = groups
+	}
+}
+
+// remoteLevelKey returns the key of a level returned by the API. Keys are only
+// known to Terraform, so unless DX returns one, it is derived from the level
+// name the same way as on import.
+func remoteLevelKey(level *dxapi.APILevel) string {
+	if level.Key != nil && *level.Key != "" {
+		return *level.Key
+	}
+	if level.Name == nil {
+		return ""
+	}
+	return slugify(*level.Name)
+}
+
+// findAPILevelByKey returns the level of the scorecard with the key, or an
+// error listing the keys of its levels. Since keys are derived from names,
+// levels named e.g. "Gold" and "gold" share a key and cannot be told apart.
+func findAPILevelByKey(scorecard *dxapi.APIScorecard, key string) (*dxapi.APILevel, error) {
+	var found []*dxapi.APILevel
+	keys := make([]string, 0, len(scorecard.Levels))
+	for _, level := range scorecard.Levels {
+		if remoteLevelKey(level) == key {
+			found = append(found, level)
+		}
+		keys = append(keys, remoteLevelKey(level))
+	}
+
+	switch len(found) {
+	case 0:
+		sort.Strings(keys)
+		return nil, fmt.Errorf("scorecard %q has no level with key %q (available keys: %s)", scorecard.Name, key, strings.Join(keys, ", "))
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("scorecard %q has %d levels with key %q, rename them in DX so that their keys differ", scorecard.Name, len(found), key)
+	}
+}
+
+// remoteCheckGroupKey returns the key of a check group returned by the API,
+// derived from its name unless DX returns one, like remoteLevelKey.
+func remoteCheckGroupKey(group *dxapi.APICheckGroup) string {
+	if group.Key != nil && *group.Key != "" {
+		return *group.Key
+	}
+	if group.Name == nil {
+		return ""
+	}
+	return slugify(*group.Name)
+}
+
+// findAPICheckGroupByKey returns the check group of the scorecard with the
+// key, or an error listing the keys of its check groups, like
+// findAPILevelByKey.
+func findAPICheckGroupByKey(scorecard *dxapi.APIScorecard, key string) (*dxapi.APICheckGroup, error) {
+	var found []*dxapi.APICheckGroup
+	keys := make([]string, 0, len(scorecard.CheckGroups))
+	for _, group := range scorecard.CheckGroups {
+		if remoteCheckGroupKey(group) == key {
+			found = append(found, group)
+		}
+		keys = append(keys, remoteCheckGroupKey(group))
+	}
+
+	switch len(found) {
+	case 0:
+		sort.Strings(keys)
+		return nil, fmt.Errorf("scorecard %q has no check group with key %q (available keys: %s)", scorecard.Name, key, strings.Join(keys, ", "))
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("scorecard %q has %d check groups with key %q, rename them in DX so that their keys differ", scorecard.Name, len(found), key)
+	}
+}
+
+// findAPILevelByName returns the level of the scorecard with the name, or an
+// error listing the names of its levels.
+func findAPILevelByName(scorecard *dxapi.APIScorecard, name string) (*dxapi.APILevel, error) {
+	names := make([]string, 0, len(scorecard.Levels))
+	for _, level := range scorecard.Levels {
+		if level.Name == nil {
+			continue
+		}
+		if *level.Name == name {
+			return level, nil
+		}
+		names = append(names, fmt.Sprintf("%q", *level.Name))
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("scorecard %q has no level named %q (available levels: %s)", scorecard.Name, name, strings.Join(names, ", "))
+}
+
+// findAPICheckGroupByName returns the check group of the scorecard with the
+// name, or an error listing the names of its check groups.
+func findAPICheckGroupByName(scorecard *dxapi.APIScorecard, name string) (*dxapi.APICheckGroup, error) {
+	names := make([]string, 0, len(scorecard.CheckGroups))
+	for _, group := range scorecard.CheckGroups {
+		if group.Name == nil {
+			continue
+		}
+		if *group.Name == name {
+			return group, nil
+		}
+		names = append(names, fmt.Sprintf("%q", *group.Name))
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("scorecard %q has no check group named %q (available check groups: %s)", scorecard.Name, name, strings.Join(names, ", "))
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScorecardReferences(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	scorecard := &dxapi.APIScorecard{
+		Type: "LEVEL",
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Name: str("Gold Tier")},
+			{Id: str("l2"), Key: str("silver"), Name: str("Silver Tier")},
+		},
+		Checks: []*dxapi.APICheck{
+			{Id: str("c1")},
+			{Id: str("c2")},
+		},
+	}
+
+	checks, levels, groups := scorecardReferences(scorecard)
+
+	expectedChecks := []map[string]interface{}{{"id": "c1"}, {"id": "c2"}}
+	if !reflect.DeepEqual(checks, expectedChecks) {
+		t.Errorf("expected checks %v, got %v", expectedChecks, checks)
+	}
+	expectedLevels := []map[string]interface{}{
+		{"id": "l1", "key": "l1"},
+		{"id": "l2", "key": "l2"},
+	}
+	if !reflect.DeepEqual(levels, expectedLevels) {
+		t.Errorf("expected levels %v, got %v", expectedLevels, levels)
+	}
+	if len(groups) != 0 {
+		t.Errorf("expected no check groups, got %v", groups)
+	}
+}
+
+func TestResolvedCheck(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	levels := &dxapi.APIScorecard{
+		Name:   "Production Readiness",
+		Type:   "LEVEL",
+		Levels: []*dxapi.APILevel{{Id: str("l1"), Name: str("Gold Tier")}},
+	}
+	points := &dxapi.APIScorecard{
+		Name:        "Security",
+		Type:        "POINTS",
+		CheckGroups: []*dxapi.APICheckGroup{{Id: str("g1"), Name: str("Secrets")}},
+	}
+	check := func(level, group types.String) scorecardCheckResourceModel {
+		return scorecardCheckResourceModel{ScorecardLevelKey: level, ScorecardCheckGroupKey: group}
+	}
+
+	testCases := map[string]struct {
+		scorecard     *dxapi.APIScorecard
+		check         scorecardCheckResourceModel
+		expectedLevel string
+		expectedGroup string
+		expectError   bool
+	}{
+		"level": {
+			scorecard:     levels,
+			check:         check(types.StringValue("gold-tier"), types.StringNull()),
+			expectedLevel: "l1",
+		},
+		"missing level key": {
+			scorecard:   levels,
+			check:       check(types.StringNull(), types.StringNull()),
+			expectError: true,
+		},
+		"name is not a key": {
+			scorecard:   levels,
+			check:       check(types.StringValue("Gold Tier"), types.StringNull()),
+			expectError: true,
+		},
+		"check group": {
+			scorecard:     points,
+			check:         check(types.StringNull(), types.StringValue("secrets")),
+			expectedGroup: "g1",
+		},
+		"level on points scorecard": {
+			scorecard:   points,
+			check:       check(types.StringValue("gold-tier"), types.StringNull()),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.check.resolvedCheck(testCase.scorecard)
+			if (err != nil) != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, err)
+			}
+			if err != nil {
+				return
+			}
+			// The check references its level or check group by the key that
+			// scorecardReferences gives it, which is its id.
+			if got.ScorecardLevelKey.ValueString() != testCase.expectedLevel || got.ScorecardCheckGroupKey.ValueString() != testCase.expectedGroup {
+				t.Errorf("expected level key %q and check group key %q, got %s and %s",
+					testCase.expectedLevel, testCase.expectedGroup, got.ScorecardLevelKey, got.ScorecardCheckGroupKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scorecardTypeRequiresReplace forces a new scorecard when the scorecard type
+// changes. DX cannot convert levels into check groups (or the reverse) in place,
+// so the scorecard is recreated and a warning lists the checks whose
+// evaluation history will be lost.
+func scorecardTypeRequiresReplace() planmodifier.String {
+	description := "Changing the scorecard type destroys the scorecard and creates a new one."
+
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+			// A type that is not known until apply may differ from the current
+			// one, and DX cannot change it in place, so replacement is planned
+			// either way.
+			resp.RequiresReplace = true
+
+			var checks []checkModel
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("checks"), &checks)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			detail := fmt.Sprintf(
+				"Changing the scorecard type from %s to %s requires the scorecard to be deleted and recreated.",
+				req.StateValue.ValueString(), req.PlanValue.ValueString(),
+			)
+			if req.PlanValue.IsUnknown() {
+				detail = fmt.Sprintf(
+					"The scorecard type is not known until apply. Because it may change from %s, the scorecard is deleted and recreated.",
+					req.StateValue.ValueString(),
+				)
+			}
+			if len(checks) > 0 {
+				names := make([]string, 0, len(checks))
+				for _, check := range checks {
+					names = append(names, fmt.Sprintf("  - %s", check.Name.ValueString()))
+				}
+				detail += " The evaluation history of the following checks will be lost:\n\n" + strings.Join(names, "\n")
+			}
+
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Scorecard type change will replace the scorecard", detail)
+		},
+		description,
+		description,
+	)
+}
+
+// derivedInt64Modifier plans a computed summary attribute from the planned
+// configuration, so it only shows as changed when its inputs change.
+type derivedInt64Modifier struct {
+	description string
+	derive      func(ctx context.Context, plan tfsdk.Plan) (types.Int64, diag.Diagnostics)
+
+	// fromChecks is set for summaries of the checks, which also cover checks
+	// that are not in state outside of exclusive checks_management.
+	fromChecks bool
+}
+
+func (m derivedInt64Modifier) Description(_ context.Context) string {
+	return m.description
+}
+
+func (m derivedInt64Modifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m derivedInt64Modifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	value, diags := m.derive(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || value.IsUnknown() {
+		return
+	}
+
+	if m.fromChecks && !req.State.Raw.IsNull() && !value.IsNull() {
+		var mode types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("checks_management"), &mode)...)
+		if resp.Diagnostics.HasError() || mode.IsUnknown() {
+			return
+		}
+
+		// The checks in state are only part of the scorecard, so apply the
+		// planned difference to the summary of the whole scorecard.
+		if mode.ValueString() != checksExclusive {
+			prior, diags := m.derive(ctx, tfsdk.Plan{Schema: req.State.Schema, Raw: req.State.Raw})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() || prior.IsUnknown() || prior.IsNull() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+				return
+			}
+			value = types.Int64Value(req.StateValue.ValueInt64() + value.ValueInt64() - prior.ValueInt64())
+		}
+	}
+	resp.PlanValue = value
+}
+
+// plannedChecks returns the planned checks, or false if they are not known yet.
+func plannedChecks(ctx context.Context, plan tfsdk.Plan) ([]checkModel, bool, diag.Diagnostics) {
+	var checks types.List
+	diags := plan.GetAttribute(ctx, path.Root("checks"), &checks)
+	if diags.HasError() || checks.IsUnknown() {
+		return nil, false, diags
+	}
+
+	var models []checkModel
+	if checks.IsNull() {
+		return models, true, diags
+	}
+	if d := checks.ElementsAs(ctx, &models, false); d.HasError() {
+		return nil, false, diags
+	}
+	return models, true, diags
+}
+
+// plannedType returns the planned scorecard type, or false if it is not known yet.
+func plannedType(ctx context.Context, plan tfsdk.Plan) (string, bool, diag.Diagnostics) {
+	var scorecardType types.String
+	diags := plan.GetAttribute(ctx, path.Root("type"), &scorecardType)
+	if diags.HasError() || scorecardType.IsUnknown() {
+		return "", false, diags
+	}
+	return scorecardType.ValueString(), true, diags
+}
+
+func checkCountFromPlan() planmodifier.Int64 {
+	return derivedInt64Modifier{
+		description: "Plans the number of checks from the configured checks.",
+		fromChecks:  true,
+		derive: func(ctx context.Context, plan tfsdk.Plan) (types.Int64, diag.Diagnostics) {
+			checks, known, diags := plannedChecks(ctx, plan)
+			if !known {
+				return types.Int64Unknown(), diags
+			}
+			return types.Int64Value(int64(len(checks))), diags
+		},
+	}
+}
+
+func maxPointsFromPlan() planmodifier.Int64 {
+	return derivedInt64Modifier{
+		description: "Plans the total points from the configured checks of a POINTS scorecard.",
+		fromChecks:  true,
+		derive: func(ctx context.Context, plan tfsdk.Plan) (types.Int64, diag.Diagnostics) {
+			scorecardType, known, diags := plannedType(ctx, plan)
+			if !known {
+				return types.Int64Unknown(), diags
+			}
+			if scorecardType != "POINTS" {
+				return types.Int64Null(), diags
+			}
+
+			checks, known, d := plannedChecks(ctx, plan)
+			diags.Append(d...)
+			if !known {
+				return types.Int64Unknown(), diags
+			}
+
+			var total int64
+			for _, check := range checks {
+				if check.Points.IsUnknown() {
+					return types.Int64Unknown(), diags
+				}
+				total += check.Points.ValueInt64()
+			}
+			return types.Int64Value(total), diags
+		},
+	}
+}
+
+func levelCountFromPlan() planmodifier.Int64 {
+	return derivedInt64Modifier{
+		description: "Plans the number of levels from the configured levels of a LEVEL scorecard.",
+		derive: func(ctx context.Context, plan tfsdk.Plan) (types.Int64, diag.Diagnostics) {
+			scorecardType, known, diags := plannedType(ctx, plan)
+			if !known {
+				return types.Int64Unknown(), diags
+			}
+			if scorecardType != "LEVEL" {
+				return types.Int64Null(), diags
+			}
+
+			var levels types.List
+			diags.Append(plan.GetAttribute(ctx, path.Root("levels"), &levels)...)
+			if diags.HasError() || levels.IsUnknown() {
+				return types.Int64Unknown(), diags
+			}
+			return types.Int64Value(int64(len(levels.Elements()))), diags
+		},
+	}
+}
+
+// positionFromList plans an unconfigured rank or ordering attribute of a list
+// element from the element's position in the list, plus the given offset.
+func positionFromList(offset int64) planmodifier.Int64 {
+	return positionModifier{offset: offset}
+}
+
+type positionModifier struct {
+	offset int64
+}
+
+func (m positionModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Defaults to the position of the element in its list, starting at %d.", m.offset)
+}
+
+func (m positionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m positionModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// The attribute path ends in <list>[<index>].<attribute>.
+	index, _ := req.Path.ParentPath().Steps().LastStep()
+	position, ok := index.(path.PathStepElementKeyInt)
+	if !ok {
+		return
+	}
+
+	resp.PlanValue = types.Int64Value(int64(position) + m.offset)
+}
+
+// keyFromName plans an unconfigured key attribute as the key derived from the
+// planned name, which is how keys are matched against DX.
+func keyFromName() planmodifier.String {
+	return keyFromNameModifier{}
+}
+
+type keyFromNameModifier struct{}
+
+func (m keyFromNameModifier) Description(_ context.Context) string {
+	return "Defaults to the key derived from the name."
+}
+
+func (m keyFromNameModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m keyFromNameModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if name.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	resp.PlanValue = types.StringValue(slugify(name.ValueString()))
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// scorecardState returns the state of a scorecard resource holding model, or
+// an empty state if model is nil.
+func scorecardState(t *testing.T, model *scorecardModel) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&scorecardResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if model == nil {
+		return state
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics building state: %v", diags)
+	}
+	return state
+}
+
+func TestScorecardTypeRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	state := scorecardState(t, &scorecardModel{
+		Id:   types.StringValue("sc1"),
+		Type: types.StringValue("LEVEL"),
+		Checks: []checkModel{
+			{Name: types.StringValue("Has owner")},
+		},
+	})
+
+	testCases := map[string]struct {
+		state           types.String
+		plan            types.String
+		expectReplace   bool
+		expectedWarning string
+	}{
+		"unchanged": {
+			state: types.StringValue("LEVEL"),
+			plan:  types.StringValue("LEVEL"),
+		},
+		"changed": {
+			state:           types.StringValue("LEVEL"),
+			plan:            types.StringValue("POINTS"),
+			expectReplace:   true,
+			expectedWarning: "from LEVEL to POINTS",
+		},
+		"unknown": {
+			state:           types.StringValue("LEVEL"),
+			plan:            types.StringUnknown(),
+			expectReplace:   true,
+			expectedWarning: "not known until apply",
+		},
+		"create": {
+			state: types.StringNull(),
+			plan:  types.StringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("type"),
+				State:       state,
+				StateValue:  testCase.state,
+				PlanValue:   testCase.plan,
+				ConfigValue: testCase.plan,
+			}
+			// A scorecard being created has no state.
+			if testCase.state.IsNull() {
+				req.State = scorecardState(t, nil)
+			}
+			req.Plan = tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			resp := &planmodifier.StringResponse{PlanValue: testCase.plan}
+
+			scorecardTypeRequiresReplace().PlanModifyString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.RequiresReplace != testCase.expectReplace {
+				t.Errorf("expected requires replace %t, got %t", testCase.expectReplace, resp.RequiresReplace)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if testCase.expectedWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), testCase.expectedWarning) || !strings.Contains(warnings[0].Detail(), "- Has owner") {
+				t.Errorf("expected a warning mentioning %q and listing the checks, got %v", testCase.expectedWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestKeyFromName(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&scorecardLevelResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := func(name types.String) tfsdk.Plan {
+		plan := tfsdk.Plan{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		model := scorecardLevelResourceModel{
+			Id:          types.StringUnknown(),
+			ScorecardId: types.StringValue("sc1"),
+			Key:         types.StringUnknown(),
+			Name:        name,
+			Color:       types.StringValue("#c0c0c0"),
+			Rank:        types.Int64Unknown(),
+		}
+		if diags := plan.Set(ctx, model); diags.HasError() {
+			t.Fatalf("unexpected diagnostics building plan: %v", diags)
+		}
+		return plan
+	}
+
+	testCases := map[string]struct {
+		config   types.String
+		name     types.String
+		expected types.String
+	}{
+		"derived": {
+			config:   types.StringNull(),
+			name:     types.StringValue("Silver Tier"),
+			expected: types.StringValue("silver-tier"),
+		},
+		"unknown name": {
+			config:   types.StringNull(),
+			name:     types.StringUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"configured": {
+			config:   types.StringValue("silver-tier"),
+			name:     types.StringValue("Silver Tier"),
+			expected: types.StringValue("silver-tier"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			planValue := testCase.config
+			if planValue.IsNull() {
+				planValue = types.StringUnknown()
+			}
+			req := planmodifier.StringRequest{
+				Path:        path.Root("key"),
+				Plan:        plan(testCase.name),
+				PlanValue:   planValue,
+				ConfigValue: testCase.config,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: planValue}
+
+			keyFromName().PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -5,23 +5,32 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/big"
 
 	"terraform-provider-scorecard/internal/provider/dxapi"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &scorecardResource{}
-// var _ resource.ResourceWithImportState = &scorecardResource{}
+var (
+	_ resource.Resource                   = &scorecardResource{}
+	_ resource.ResourceWithUpgradeState   = &scorecardResource{}
+	_ resource.ResourceWithImportState    = &scorecardResource{}
+	_ resource.ResourceWithIdentity       = &scorecardResource{}
+	_ resource.ResourceWithValidateConfig = &scorecardResource{}
+	_ resource.ResourceWithModifyPlan     = &scorecardResource{}
+)
 
 func NewScorecardResource() resource.Resource {
 	return &scorecardResource{}
@@ -30,77 +39,111 @@ func NewScorecardResource() resource.Resource {
 // scorecardResource defines the resource implementation.
 type scorecardResource struct {
 	client *dxapi.Client
+
+	// defaultDeletionProtection is the provider-level default for
+	// deletion_protection.
+	defaultDeletionProtection bool
+
+	// requireAckForDestructiveChanges turns the warnings for destructive
+	// changes into errors unless they are acknowledged.
+	requireAckForDestructiveChanges bool
 }
 
 // scorecardModel describes the resource data model.
 type scorecardModel struct {
 	// Required fields
-    Id          				types.String `tfsdk:"id"`
-    Name        				types.String `tfsdk:"name"`
-	Type        				types.String `tfsdk:"type"`
-	EntityFilterType 			types.String `tfsdk:"entity_filter_type"`
-	EvaluationFrequency 		types.Number `tfsdk:"evaluation_frequency_hours"`
-	
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	EntityFilterType    types.String `tfsdk:"entity_filter_type"`
+	EvaluationFrequency types.Int64  `tfsdk:"evaluation_frequency_hours"`
+
 	// Conditionally required fields for levels based scorecards
-	EmptyLevelLabel 			types.String `tfsdk:"empty_level_label"`
-	EmptyLevelColor 			types.String `tfsdk:"empty_level_color"`
-	Levels      				[]levelModel `tfsdk:"levels"`
+	EmptyLevelLabel types.String `tfsdk:"empty_level_label"`
+	EmptyLevelColor types.String `tfsdk:"empty_level_color"`
+	Levels          []levelModel `tfsdk:"levels"`
 
 	// Conditionally required fields for points based scorecards
-	CheckGroups 				[]checkGroupModel `tfsdk:"check_groups"`
+	CheckGroups []checkGroupModel `tfsdk:"check_groups"`
 
 	// Optional fields
-    Description 				types.String `tfsdk:"description"`
-	Published      				types.Bool `tfsdk:"published"`
+	Description                 types.String   `tfsdk:"description"`
+	Published                   types.Bool     `tfsdk:"published"`
 	EntityFilterTypeIdentifiers []types.String `tfsdk:"entity_filter_type_identifiers"`
-	EntityFilterSql 			types.String `tfsdk:"entity_filter_sql"`
-    Checks      				[]checkModel `tfsdk:"checks"`
+	EntityFilterSql             sqlStringValue `tfsdk:"entity_filter_sql"`
+	Checks                      []checkModel   `tfsdk:"checks"`
+	CloneFromScorecardId        types.String   `tfsdk:"clone_from_scorecard_id"`
+
+	// Computed metadata
+	Url             types.String `tfsdk:"url"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	LastEvaluatedAt types.String `tfsdk:"last_evaluated_at"`
+	CheckCount      types.Int64  `tfsdk:"check_count"`
+	MaxPoints       types.Int64  `tfsdk:"max_points"`
+	LevelCount      types.Int64  `tfsdk:"level_count"`
+
+	// Provider-only settings
+	DeletionProtection            types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy                     types.String `tfsdk:"on_destroy"`
+	OverwriteRemoteChanges        types.Bool   `tfsdk:"overwrite_remote_changes"`
+	AcknowledgeDestructiveChanges types.Bool   `tfsdk:"acknowledge_destructive_changes"`
+	PublishStrategy               types.String `tfsdk:"publish_strategy"`
+	ChecksManagement              types.String `tfsdk:"checks_management"`
+	PublishTimeout                types.String `tfsdk:"publish_timeout"`
+	PublishPollInterval           types.String `tfsdk:"publish_poll_interval"`
+
+	WaitForEvaluation *waitForEvaluationModel `tfsdk:"wait_for_evaluation"`
+}
+
+type waitForEvaluationModel struct {
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
 }
 
 type levelModel struct {
-	Key 	types.String `tfsdk:"key"`
-	Id  	types.String `tfsdk:"id"`
-	Name  	types.String `tfsdk:"name"`
-	Color 	types.String `tfsdk:"color"`
-	Rank  	types.Number `tfsdk:"rank"`
+	Key   types.String `tfsdk:"key"`
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Color types.String `tfsdk:"color"`
+	Rank  types.Int64  `tfsdk:"rank"`
 }
 
 type checkGroupModel struct {
-	Key 		types.String `tfsdk:"key"`
-	Id  		types.String `tfsdk:"id"`
-	Name  		types.String `tfsdk:"name"`
-	Ordering 	types.Number `tfsdk:"ordering"`
+	Key      types.String `tfsdk:"key"`
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Ordering types.Int64  `tfsdk:"ordering"`
 }
 
 type checkModel struct {
-	Id 				types.String `tfsdk:"id"`
-	Name 			types.String `tfsdk:"name"`
-	Description 	types.String `tfsdk:"description"`
-	Ordering 		types.Number `tfsdk:"ordering"`
-	Sql 			types.String `tfsdk:"sql"`
-	FilterSql 		types.String `tfsdk:"filter_sql"`
-	FilterMessage 	types.String `tfsdk:"filter_message"`
-	OutputEnabled 	types.Bool `tfsdk:"output_enabled"`
-	
-	OutputType 			types.String `tfsdk:"output_type"`
-	OutputAggregation 	types.String `tfsdk:"output_aggregation"`
-	OutputCustomOptions types.String `tfsdk:"output_custom_options"` //TODO figure out how to model this
-
-	EstimatedDevDays 	types.Number `tfsdk:"estimated_dev_days"`
-	ExternalUrl			types.String `tfsdk:"external_url"`
-	Published 			types.Bool `tfsdk:"published"`
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Ordering      types.Int64    `tfsdk:"ordering"`
+	Sql           sqlStringValue `tfsdk:"sql"`
+	FilterSql     sqlStringValue `tfsdk:"filter_sql"`
+	FilterMessage types.String   `tfsdk:"filter_message"`
+	OutputEnabled types.Bool     `tfsdk:"output_enabled"`
+
+	OutputType          types.String    `tfsdk:"output_type"`
+	OutputAggregation   types.String    `tfsdk:"output_aggregation"`
+	OutputCustomOptions jsonStringValue `tfsdk:"output_custom_options"`
+
+	EstimatedDevDays types.Int64  `tfsdk:"estimated_dev_days"`
+	ExternalUrl      types.String `tfsdk:"external_url"`
+	Published        types.Bool   `tfsdk:"published"`
 
 	// Additional fields for level based scorecards
-	ScorecardLevelKey 	types.String `tfsdk:"scorecard_level_key"`
-	Level 				levelModel `tfsdk:"level"`
+	ScorecardLevelKey types.String `tfsdk:"scorecard_level_key"`
+	Level             *levelModel  `tfsdk:"level"`
 
 	// Additional fields for points based scorecards
-	ScorecardCheckGroupKey 	types.String `tfsdk:"scorecard_check_group_key"`
-	CheckGroup 				checkGroupModel `tfsdk:"check_group"`
-	Points 					types.Number `tfsdk:"points"`
+	ScorecardCheckGroupKey types.String     `tfsdk:"scorecard_check_group_key"`
+	CheckGroup             *checkGroupModel `tfsdk:"check_group"`
+	Points                 types.Int64      `tfsdk:"points"`
 }
 
-
 func (r *scorecardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_scorecard"
 }
@@ -111,27 +154,37 @@ func (r *scorecardResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*dxapi.Client)
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultDeletionProtection = providerData.deletionProtection
+	r.requireAckForDestructiveChanges = providerData.requireAckForDestructiveChanges
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
 		return
 	}
 }
 
+// Options for on_destroy.
+const (
+	onDestroyDelete    = "delete"
+	onDestroyUnpublish = "unpublish"
+	onDestroyAbandon   = "abandon"
+)
+
 func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a DX Scorecard.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -145,18 +198,18 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The name of the scorecard.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-				  },
+				},
 			},
 			"type": schema.StringAttribute{
 				Required:    true,
-				Description: "The type of scorecard. Options: 'LEVEL', 'POINTS'.",
+				Description: "The type of scorecard. Options: 'LEVEL', 'POINTS'. Changing the type forces a new scorecard.",
 				// Validators: []validator.String{
 				// 	stringvalidator.OneOf("LEVEL", "POINTS"),
 				// },
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-				  },
-				
+					scorecardTypeRequiresReplace(),
+				},
 			},
 			"entity_filter_type": schema.StringAttribute{
 				Required:    true,
@@ -166,17 +219,17 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				// },
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-				  },
+				},
 			},
-			"evaluation_frequency_hours": schema.NumberAttribute{
+			"evaluation_frequency_hours": schema.Int64Attribute{
 				Required:    true,
 				Description: "How often the scorecard is evaluated (in hours). [2|4|8|24]",
-				// Validators: []validator.Number{
-				// 	numbervalidator.OneOf(2, 4, 8, 24),
+				// Validators: []validator.Int64{
+				// 	int64validator.OneOf(2, 4, 8, 24),
 				// },
-				PlanModifiers: []planmodifier.Number{
-					numberplanmodifier.UseStateForUnknown(),
-				  },
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 
 			// Conditionally required for levels-based scorecards
@@ -190,14 +243,23 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"levels": schema.ListNestedAttribute{
 				Optional:    true,
-				Description: "The levels that can be achieved in this scorecard (levels scorecards only).",
+				Computed:    true,
+				Description: "The levels that can be achieved in this scorecard (levels scorecards only). Defaults to those of the scorecard set in clone_from_scorecard_id, if any.",
+				PlanModifiers: []planmodifier.List{
+					clonedFromSource(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"key":   schema.StringAttribute{Required: true},
 						"id":    schema.StringAttribute{Computed: true},
 						"name":  schema.StringAttribute{Required: true},
 						"color": schema.StringAttribute{Required: true},
-						"rank":  schema.NumberAttribute{Required: true},
+						"rank": schema.Int64Attribute{
+							Optional:      true,
+							Computed:      true,
+							Description:   "The rank of the level. Defaults to the level's position in the list, starting at 1.",
+							PlanModifiers: []planmodifier.Int64{positionFromList(1)},
+						},
 					},
 				},
 			},
@@ -205,13 +267,22 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			// Conditionally required for points-based scorecards
 			"check_groups": schema.ListNestedAttribute{
 				Optional:    true,
-				Description: "Groups of checks, to help organize the scorecard for entity owners (points scorecards only).",
+				Computed:    true,
+				Description: "Groups of checks, to help organize the scorecard for entity owners (points scorecards only). Defaults to those of the scorecard set in clone_from_scorecard_id, if any.",
+				PlanModifiers: []planmodifier.List{
+					clonedFromSource(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"key":      schema.StringAttribute{Required: true},
-						"id":       schema.StringAttribute{Computed: true},
-						"name":     schema.StringAttribute{Required: true},
-						"ordering": schema.NumberAttribute{Required: true},
+						"key":  schema.StringAttribute{Required: true},
+						"id":   schema.StringAttribute{Computed: true},
+						"name": schema.StringAttribute{Required: true},
+						"ordering": schema.Int64Attribute{
+							Optional:      true,
+							Computed:      true,
+							Description:   "The ordering of the check group. Defaults to the group's position in the list, starting at 0.",
+							PlanModifiers: []planmodifier.Int64{positionFromList(0)},
+						},
 					},
 				},
 			},
@@ -231,30 +302,136 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "List of entity type identifiers that the scorecard should run against.",
 			},
 			"entity_filter_sql": schema.StringAttribute{
+				CustomType:  sqlStringType{},
 				Optional:    true,
 				Description: "Custom SQL used to filter entities that the scorecard should run against.",
 			},
 
-			// For now, all check field are required. This may change in the future.
+			"clone_from_scorecard_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of a scorecard to copy the levels, check groups and checks from when the scorecard is created. Configured levels, check groups and checks override the copied ones, and keys are derived from the names. The copied content is managed like configured content, and changing this attribute afterwards has no effect.",
+			},
+
+			// Computed metadata
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the scorecard in the DX web app.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scorecard was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the scorecard was last updated.",
+			},
+			"last_evaluated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When DX last evaluated the scorecard, as of the last create, update or refresh.",
+			},
+			"check_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of checks in the scorecard.",
+				PlanModifiers: []planmodifier.Int64{
+					checkCountFromPlan(),
+				},
+			},
+			"max_points": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total points an entity can earn (points scorecards only).",
+				PlanModifiers: []planmodifier.Int64{
+					maxPointsFromPlan(),
+				},
+			},
+			"level_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of levels in the scorecard (levels scorecards only).",
+				PlanModifiers: []planmodifier.Int64{
+					levelCountFromPlan(),
+				},
+			},
+
+			// Provider-only settings
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Terraform is prevented from destroying the scorecard. Does not apply when on_destroy is 'abandon', which leaves the scorecard in DX. Defaults to the provider's 'deletion_protection' setting, so changing that setting plans an update of every scorecard that does not set this.",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDestroyDelete),
+				Description: "What happens to the scorecard in DX when the resource is destroyed. Options: 'delete' (the default) deletes it, 'unpublish' unpublishes it and 'abandon' leaves it untouched.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyDelete, onDestroyUnpublish, onDestroyAbandon),
+				},
+			},
+
+			"overwrite_remote_changes": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether updates may overwrite changes made to the scorecard in DX since Terraform last read it. When false (the default), such updates fail and list the remote changes.",
+			},
+			"acknowledge_destructive_changes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Acknowledges the destructive changes in the plan, such as removing checks or levels from a published scorecard. Only needed when the provider's 'require_ack_for_destructive_changes' setting is enabled. Only acknowledges the plan it is set in: remove it once the changes are applied, and set it again for later destructive changes.",
+			},
+			"publish_strategy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(publishImmediate),
+				Description: "How a published scorecard is published when it is created or its content changes. Options: 'immediate' (the default) writes it published, 'after_first_evaluation' writes it unpublished and publishes it once DX has evaluated the changes. If a new scorecard cannot be published in time, it is created with a warning, shows as unpublished on the next refresh, and the next apply publishes it.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(publishImmediate, publishAfterFirstEvaluation),
+				},
+			},
+			"checks_management": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(checksExclusive),
+				Description: "How the checks of the scorecard are managed. Options: 'exclusive' (the default) makes 'checks' the complete list of checks and removes any other check, 'additive' only manages the checks created through 'checks' and keeps checks added in DX or by other configurations, 'ignore' leaves the checks of the scorecard alone and does not allow 'checks' to be configured.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(checksExclusive, checksAdditive, checksIgnore),
+				},
+			},
+			"publish_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultEvaluationTimeout),
+				Description: "How long to wait for DX to evaluate the scorecard before publishing it, e.g. \"30m\" (the default). Only used with publish_strategy = \"after_first_evaluation\".",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"publish_poll_interval": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultEvaluationPollInterval),
+				Description: "How often to check whether DX has evaluated the scorecard, e.g. \"30s\" (the default). Only used with publish_strategy = \"after_first_evaluation\".",
+				Validators:  []validator.String{durationValidator{}},
+			},
+
 			"checks": schema.ListNestedAttribute{
 				Optional:    true,
-				Description: "List of checks that are applied to entities in the scorecard.",
+				Computed:    true,
+				Description: "List of checks that are applied to entities in the scorecard. Defaults to those of the scorecard set in clone_from_scorecard_id, if any.",
+				PlanModifiers: []planmodifier.List{
+					clonedFromSource(),
+				},
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id":               schema.StringAttribute{Computed: true},
-						"name":             schema.StringAttribute{Required: true},
-						"description":      schema.StringAttribute{Required: true},
-						"ordering":         schema.NumberAttribute{Required: true},
-						"sql":              schema.StringAttribute{Required: true},
-						"filter_sql":       schema.StringAttribute{Required: true},
-						"filter_message":   schema.StringAttribute{Required: true},
-						"output_enabled":   schema.BoolAttribute{Required: true},
-						"output_type":      schema.StringAttribute{Required: true},
-						"output_aggregation": schema.StringAttribute{Required: true},
-						"output_custom_options": schema.StringAttribute{Required: true}, // JSON string (you may eventually want to use a map)
-						"estimated_dev_days":    schema.NumberAttribute{Required: true},
-						"external_url":          schema.StringAttribute{Required: true},
-						"published":             schema.BoolAttribute{Required: true},
+					Attributes: checkAttributes(map[string]schema.Attribute{
+						"id": schema.StringAttribute{Computed: true},
+						"ordering": schema.Int64Attribute{
+							Optional:      true,
+							Computed:      true,
+							Description:   "The ordering of the check. Defaults to the check's position in the list, starting at 0.",
+							PlanModifiers: []planmodifier.Int64{positionFromList(0)},
+						},
 
 						// Fields for level-based scorecards
 						"scorecard_level_key": schema.StringAttribute{Optional: true},
@@ -265,23 +442,43 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								"id":    schema.StringAttribute{Computed: true},
 								"name":  schema.StringAttribute{Required: true},
 								"color": schema.StringAttribute{Required: true},
-								"rank":  schema.NumberAttribute{Required: true},
+								"rank":  schema.Int64Attribute{Required: true},
 							},
 						},
 
 						// Fields for points-based scorecards
 						"scorecard_check_group_key": schema.StringAttribute{Optional: true},
 						"check_group": schema.SingleNestedAttribute{
-							Optional: true,
+							Optional:    true,
 							Description: "Optional check group. If provided, all its fields (except 'id') are required.",
 							Attributes: map[string]schema.Attribute{
 								"key":      schema.StringAttribute{Required: true},
 								"id":       schema.StringAttribute{Computed: true},
 								"name":     schema.StringAttribute{Required: true},
-								"ordering": schema.NumberAttribute{Required: true},
+								"ordering": schema.Int64Attribute{Required: true},
 							},
 						},
-						"points": schema.NumberAttribute{Optional: true},
+					}),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"wait_for_evaluation": schema.SingleNestedBlock{
+				Description: "When set, creating or changing the scorecard waits until DX has evaluated the changes, so its results are up to date once the apply completes.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(defaultEvaluationTimeout),
+						Description: "How long to wait for the evaluation, e.g. \"30m\" (the default).",
+						Validators:  []validator.String{durationValidator{}},
+					},
+					"poll_interval": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(defaultEvaluationPollInterval),
+						Description: "How often to check whether DX has evaluated the scorecard, e.g. \"30s\" (the default).",
+						Validators:  []validator.String{durationValidator{}},
 					},
 				},
 			},
@@ -289,11 +486,95 @@ func (r *scorecardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// checkAttributes returns the attributes that define a check, plus the given
+// extra attributes. They are shared by the checks of scorecard_scorecard and
+// the scorecard_check resource. Only the fields that define a check are
+// required; the remaining fields default to the values DX stores when they are
+// omitted.
+func checkAttributes(extra map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+		"sql":  schema.StringAttribute{CustomType: sqlStringType{}, Required: true},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "Description of the check. Defaults to an empty string.",
+		},
+		"filter_sql": schema.StringAttribute{
+			CustomType:  sqlStringType{},
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "SQL used to exclude entities from the check. Defaults to an empty string.",
+		},
+		"filter_message": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "Message shown for entities excluded by 'filter_sql'. Defaults to an empty string.",
+		},
+		"output_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the check records an output value. Defaults to false.",
+		},
+		"output_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "The type of the check output. Options: 'string', 'integer', 'float', 'percent', 'duration'. Only used when 'output_enabled' is true.",
+		},
+		"output_aggregation": schema.StringAttribute{
+			Optional:    true,
+			Description: "How check outputs are aggregated. Options: 'sum', 'average', 'median', 'min', 'max', 'count'; 'string' outputs can only be counted and 'percent' outputs cannot be summed. Only used when 'output_enabled' is true.",
+		},
+		"output_custom_options": schema.StringAttribute{
+			CustomType:  jsonStringType{},
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "JSON object of custom output options: 'unit' for 'integer' and 'float' outputs, or one of 'seconds', 'minutes', 'hours', 'days' for 'duration' outputs, and 'decimals' for 'float' and 'percent' outputs. Key order and formatting are ignored when comparing. Defaults to an empty string.",
+		},
+		"estimated_dev_days": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Estimated number of developer days needed to make the check pass.",
+		},
+		"external_url": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "Link to documentation for the check. Defaults to an empty string.",
+		},
+		"published": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the check is published. Defaults to false.",
+		},
+
+		"points": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The points an entity earns by passing the check (points scorecards only).",
+		},
+	}
+	for name, attribute := range extra {
+		attributes[name] = attribute
+	}
+	return attributes
+}
 
 func (r *scorecardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Seed the content to clone if the source was not known when planning.
+	seeded := req.Plan
+	diags := r.seedClonedContent(ctx, &seeded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan scorecardModel
-	diags := req.Plan.Get(ctx, &plan)
+	diags = seeded.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -344,13 +625,66 @@ func (r *scorecardResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Construct API request payload
+	payload := scorecardPayload(plan)
+
+	// Create the scorecard unpublished when it is only published once evaluated.
+	publishAfterEvaluation := publishesAfterEvaluation(plan)
+	if publishAfterEvaluation {
+		payload["published"] = false
+	}
+
+	// Create Scorecard (apiResp is a struct of type APIResponse)
+	apiResp, err := r.client.CreateScorecard(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating scorecard", err.Error())
+		return
+	}
+
+	// Every check of a new scorecard was created from the configuration.
+	view, diags := newChecksView(ctx, plan.ChecksManagement.ValueString(), nil, plan)
+	resp.Diagnostics.Append(diags...)
+	view = view.afterWrite(apiResp.Scorecard, map[string]bool{})
+
+	// If publishing fails, the scorecard is still saved to state, with a
+	// warning rather than an error so it is not tainted, and the next apply
+	// publishes it in place. Publishing already waits for an evaluation of the
+	// new scorecard.
+	if publishAfterEvaluation {
+		apiResp, diags = r.publishAfterEvaluation(ctx, plan, apiResp, true)
+		resp.Diagnostics.Append(diags...)
+	} else if plan.WaitForEvaluation != nil {
+		apiResp, diags = r.awaitEvaluation(ctx, plan.WaitForEvaluation, apiResp, true)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Shallow copy of plan to preserve values
+	oldPlan := plan
+	view.mapResponse(apiResp, &plan, &oldPlan)
+	plan.Url = stringOrNull(r.client.ScorecardURL(plan.Id.ValueString()))
+
+	pending := keepPlannedPublished(&plan, oldPlan.Published, publishAfterEvaluation, apiResp.Scorecard)
+	resp.Diagnostics.Append(setPendingPublish(ctx, resp.Private, pending)...)
+	resp.Diagnostics.Append(setRemoteVersion(ctx, resp.Private, view.scorecard(apiResp.Scorecard))...)
+	resp.Diagnostics.Append(view.save(ctx, resp.Private)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
+}
+
+// scorecardPayload builds the full API representation of a scorecard for the
+// create endpoint. Ids are only included once they are known.
+func scorecardPayload(plan scorecardModel) map[string]interface{} {
+	scorecardType := plan.Type.ValueString()
+
 	payload := map[string]interface{}{
 		// Required fields
-		"name":                 plan.Name.ValueString(),
-		"type":                 scorecardType,
-		"entity_filter_type":   plan.EntityFilterType.ValueString(),
-		"evaluation_frequency_hours": plan.EvaluationFrequency.ValueBigFloat(),
+		"name":                       plan.Name.ValueString(),
+		"type":                       scorecardType,
+		"entity_filter_type":         plan.EntityFilterType.ValueString(),
+		"evaluation_frequency_hours": plan.EvaluationFrequency.ValueInt64(),
 	}
+	setIfKnown(payload, "id", plan.Id)
 
 	// Add LEVEL-specific required fields
 	if scorecardType == "LEVEL" {
@@ -359,12 +693,14 @@ func (r *scorecardResource) Create(ctx context.Context, req resource.CreateReque
 
 		levels := []map[string]interface{}{}
 		for _, level := range plan.Levels {
-			levels = append(levels, map[string]interface{}{
+			levelPayload := map[string]interface{}{
 				"key":   level.Key.ValueString(),
 				"name":  level.Name.ValueString(),
 				"color": level.Color.ValueString(),
-				"rank":  level.Rank.ValueBigFloat(),
-			})
+				"rank":  level.Rank.ValueInt64(),
+			}
+			setIfKnown(levelPayload, "id", level.Id)
+			levels = append(levels, levelPayload)
 		}
 		payload["levels"] = levels
 	}
@@ -373,11 +709,13 @@ func (r *scorecardResource) Create(ctx context.Context, req resource.CreateReque
 	if scorecardType == "POINTS" {
 		checkGroups := []map[string]interface{}{}
 		for _, group := range plan.CheckGroups {
-			checkGroups = append(checkGroups, map[string]interface{}{
+			groupPayload := map[string]interface{}{
 				"key":      group.Key.ValueString(),
 				"name":     group.Name.ValueString(),
-				"ordering": group.Ordering,
-			})
+				"ordering": group.Ordering.ValueInt64(),
+			}
+			setIfKnown(groupPayload, "id", group.Id)
+			checkGroups = append(checkGroups, groupPayload)
 		}
 		payload["check_groups"] = checkGroups
 	}
@@ -405,98 +743,89 @@ func (r *scorecardResource) Create(ctx context.Context, req resource.CreateReque
 	// Add checks
 	checks := []map[string]interface{}{}
 	for _, check := range plan.Checks {
-		checkPayload := map[string]interface{}{
-			"name":                 check.Name.ValueString(),
-			"description":          check.Description.ValueString(),
-			"ordering":             check.Ordering,
-			"sql":                  check.Sql.ValueString(),
-			"filter_sql":           check.FilterSql.ValueString(),
-			"filter_message":       check.FilterMessage.ValueString(),
-			"output_enabled":       check.OutputEnabled.ValueBool(),
-			"output_type":          check.OutputType.ValueString(),
-			"output_aggregation":   check.OutputAggregation.ValueString(),
-			"output_custom_options": check.OutputCustomOptions.ValueString(),
-			"estimated_dev_days":   check.EstimatedDevDays,
-			"external_url":         check.ExternalUrl.ValueString(),
-			"published":            check.Published.ValueBool(),
-		}
+		checks = append(checks, checkPayload(check, scorecardType))
+	}
+	payload["checks"] = checks
+
+	return payload
+}
 
-		// Add LEVEL-specific check fields
-		if scorecardType == "LEVEL" {
-			checkPayload["scorecard_level_key"] = check.ScorecardLevelKey.ValueString()
-			checkPayload["level"] = map[string]interface{}{
+// setIfKnown adds a string to a payload unless it is null, unknown or empty.
+func setIfKnown(payload map[string]interface{}, key string, value types.String) {
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		payload[key] = value.ValueString()
+	}
+}
+
+// checkPayload builds the API representation of a check. Optional fields that
+// are null are omitted so that DX applies its own defaults.
+func checkPayload(check checkModel, scorecardType string) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":                  check.Name.ValueString(),
+		"description":           check.Description.ValueString(),
+		"ordering":              check.Ordering.ValueInt64(),
+		"sql":                   check.Sql.ValueString(),
+		"filter_sql":            check.FilterSql.ValueString(),
+		"filter_message":        check.FilterMessage.ValueString(),
+		"output_enabled":        check.OutputEnabled.ValueBool(),
+		"output_custom_options": check.OutputCustomOptions.ValueString(),
+		"external_url":          check.ExternalUrl.ValueString(),
+		"published":             check.Published.ValueBool(),
+	}
+	setIfKnown(payload, "id", check.Id)
+	if !check.OutputType.IsNull() {
+		payload["output_type"] = check.OutputType.ValueString()
+	}
+	if !check.OutputAggregation.IsNull() {
+		payload["output_aggregation"] = check.OutputAggregation.ValueString()
+	}
+	if !check.EstimatedDevDays.IsNull() {
+		payload["estimated_dev_days"] = check.EstimatedDevDays.ValueInt64()
+	}
+
+	// Add LEVEL-specific check fields
+	if scorecardType == "LEVEL" {
+		payload["scorecard_level_key"] = check.ScorecardLevelKey.ValueString()
+		if check.Level != nil {
+			level := map[string]interface{}{
 				"key":   check.Level.Key.ValueString(),
-				"id":    check.Level.Id.ValueString(),
 				"name":  check.Level.Name.ValueString(),
 				"color": check.Level.Color.ValueString(),
-				"rank":  check.Level.Rank.ValueBigFloat(),
+				"rank":  check.Level.Rank.ValueInt64(),
 			}
+			setIfKnown(level, "id", check.Level.Id)
+			payload["level"] = level
 		}
+	}
 
-		// Add POINTS-specific check fields
-		if scorecardType == "POINTS" {
-			checkPayload["scorecard_check_group_key"] = check.ScorecardCheckGroupKey.ValueString()
-			checkPayload["check_group"] = map[string]interface{}{
+	// Add POINTS-specific check fields
+	if scorecardType == "POINTS" {
+		payload["scorecard_check_group_key"] = check.ScorecardCheckGroupKey.ValueString()
+		if check.CheckGroup != nil {
+			group := map[string]interface{}{
 				"key":      check.CheckGroup.Key.ValueString(),
 				"name":     check.CheckGroup.Name.ValueString(),
-				"ordering": check.CheckGroup.Ordering,
+				"ordering": check.CheckGroup.Ordering.ValueInt64(),
 			}
-			checkPayload["points"] = check.Points
+			setIfKnown(group, "id", check.CheckGroup.Id)
+			payload["check_group"] = group
+		}
+		if !check.Points.IsNull() {
+			payload["points"] = check.Points.ValueInt64()
 		}
-
-		checks = append(checks, checkPayload)
-	}
-	payload["checks"] = checks
-
-	// Create Scorecard (apiResp is a struct of type APIResponse)
-	apiResp, err := r.client.CreateScorecard(ctx, payload)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating scorecard", err.Error())
-		return
 	}
-	
-	// Shallow copy of plan to preserve values
-	oldPlan := plan
-	mapApiResponseToTerraformModel(apiResp, &plan, &oldPlan)
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	return payload
 }
 
 func mapApiResponseToTerraformModel(apiResp *dxapi.APIResponse, plan *scorecardModel, oldPlan *scorecardModel) {
-	
-	// ************** Helper functions **************
-
-	// Helper checks for and handles nil strings
-	stringOrNull := func(s *string) types.String {
-		if s != nil {
-			return types.StringValue(*s)
-		}
-		return types.StringNull() 
-	}
-
-	// Helper preserves the value of a bool field if it's null in the plan
-	boolApiToTF := func(apiVal bool, planVal types.Bool) types.Bool {
-		if planVal.IsNull() && !apiVal {
-			return types.BoolNull()
-		}
-		return types.BoolValue(apiVal)
-	}
-
-	// Helper checks for and handles nil ints
-	numberOrNull := func(n *int) types.Number {
-		if n != nil {
-			return types.NumberValue(big.NewFloat(float64(*n)))
-		}
-		return types.NumberNull()
-	}
 
 	// ************** Required fields **************
 	plan.Id = types.StringValue(apiResp.Scorecard.Id)
 	plan.Name = types.StringValue(apiResp.Scorecard.Name)
 	plan.Type = types.StringValue(apiResp.Scorecard.Type)
 	plan.EntityFilterType = types.StringValue(apiResp.Scorecard.EntityFilterType)
-	plan.EvaluationFrequency = types.NumberValue(big.NewFloat(float64(apiResp.Scorecard.EvaluationFrequency)))
+	plan.EvaluationFrequency = types.Int64Value(int64(apiResp.Scorecard.EvaluationFrequency))
 
 	// ************** Conditionally required fields for levels based scorecards **************
 	plan.EmptyLevelLabel = stringOrNull(apiResp.Scorecard.EmptyLevelLabel)
@@ -513,11 +842,11 @@ func mapApiResponseToTerraformModel(apiResp *dxapi.APIResponse, plan *scorecardM
 			}
 			plan.Levels[i] = levelModel{
 				// Key not returned by API. Leave same as plan.
-				Key:   oldLevel.Key,
+				Key:   keyOrDerived(oldLevel.Key, lvl.Name),
 				Id:    stringOrNull(lvl.Id),
 				Name:  stringOrNull(lvl.Name),
 				Color: stringOrNull(lvl.Color),
-				Rank:  numberOrNull(lvl.Rank),
+				Rank:  int64OrNull(lvl.Rank),
 			}
 		}
 	} else {
@@ -537,19 +866,19 @@ func mapApiResponseToTerraformModel(apiResp *dxapi.APIResponse, plan *scorecardM
 			}
 			plan.CheckGroups[i] = checkGroupModel{
 				// Key not returned by API. Leave same as plan.
-				Key:      prevCheckGroup.Key,
+				Key:      keyOrDerived(prevCheckGroup.Key, grp.Name),
 				Id:       stringOrNull(grp.Id),
 				Name:     stringOrNull(grp.Name),
-				Ordering: numberOrNull(grp.Ordering),
+				Ordering: int64OrNull(grp.Ordering),
 			}
 		}
 	} else {
 		plan.CheckGroups = oldPlan.CheckGroups
 	}
-	
+
 	// ************** Optional fields **************
 	plan.Description = stringOrNull(apiResp.Scorecard.Description)
-	plan.EntityFilterSql = stringOrNull(apiResp.Scorecard.EntityFilterSql)
+	plan.EntityFilterSql = sqlOrNull(apiResp.Scorecard.EntityFilterSql)
 	plan.Published = boolApiToTF(apiResp.Scorecard.Published, plan.Published)
 
 	// If there are entity filter type identifiers, update the plan.EntityFilterTypeIdentifiers
@@ -562,7 +891,7 @@ func mapApiResponseToTerraformModel(apiResp *dxapi.APIResponse, plan *scorecardM
 	} else {
 		plan.EntityFilterTypeIdentifiers = oldPlan.EntityFilterTypeIdentifiers
 	}
-	
+
 	// If there are checks in the API response, update the plan.Checks
 	if len(apiResp.Scorecard.Checks) > 0 {
 		plan.Checks = make([]checkModel, len(apiResp.Scorecard.Checks))
@@ -570,47 +899,170 @@ func mapApiResponseToTerraformModel(apiResp *dxapi.APIResponse, plan *scorecardM
 			var prevCheck checkModel
 			if i < len(oldPlan.Checks) {
 				prevCheck = oldPlan.Checks[i]
+			} else {
+				// The check is not known to Terraform yet (e.g. after an import),
+				// so derive its level and check group keys from the mapped ones.
+				prevCheck.ScorecardLevelKey = levelKeyFor(chk.Level, plan.Levels)
+				prevCheck.ScorecardCheckGroupKey = checkGroupKeyFor(chk.CheckGroup, plan.CheckGroups)
 			}
-			plan.Checks[i] = checkModel{
-				Id:              stringOrNull(chk.Id),
-				Name:            stringOrNull(chk.Name),
-				Description:     stringOrNull(chk.Description),
-				Ordering:        numberOrNull(chk.Ordering),
-				Sql:             stringOrNull(chk.Sql),
-				FilterSql:       stringOrNull(chk.FilterSql),
-				FilterMessage:   stringOrNull(chk.FilterMessage),
-				OutputEnabled:   boolApiToTF(chk.OutputEnabled, plan.Checks[i].OutputEnabled),
-				OutputType:      stringOrNull(chk.OutputType),
-				OutputAggregation: stringOrNull(chk.OutputAggregation),
-				OutputCustomOptions: stringOrNull(chk.OutputCustomOptions),
-				EstimatedDevDays: numberOrNull(chk.EstimatedDevDays),
-				ExternalUrl:     stringOrNull(chk.ExternalUrl),
-				Published:       boolApiToTF(chk.Published, plan.Checks[i].Published),
-				// Key not returned by API. Leave same as plan.
-				ScorecardLevelKey: prevCheck.ScorecardLevelKey,
-				Level: levelModel{
-					// Key not returned by API. Leave same as plan.
-					Key:   prevCheck.Level.Key,
-					Id:    stringOrNull(chk.Level.Id),
-					Name:  stringOrNull(chk.Level.Name),
-					Color: stringOrNull(chk.Level.Color),
-					Rank:  numberOrNull(chk.Level.Rank),
-				},
-				// Key not returned by API. Leave same as plan.
-				ScorecardCheckGroupKey: prevCheck.ScorecardCheckGroupKey,
-				CheckGroup: checkGroupModel{
-					// Key not returned by API. Leave same as plan.
-					Key:      prevCheck.CheckGroup.Key,
-					Id:       stringOrNull(chk.CheckGroup.Id),
-					Name:     stringOrNull(chk.CheckGroup.Name),
-					Ordering: numberOrNull(chk.CheckGroup.Ordering),
-				},
-				Points: numberOrNull(chk.Points),
-			}
+			plan.Checks[i] = checkModelFromAPI(chk, prevCheck)
 		}
 	} else {
 		plan.Checks = oldPlan.Checks
 	}
+
+	// ************** Computed metadata **************
+	plan.CreatedAt = stringOrNull(apiResp.Scorecard.CreatedAt)
+	plan.UpdatedAt = stringOrNull(apiResp.Scorecard.UpdatedAt)
+	plan.LastEvaluatedAt = stringOrNull(apiResp.Scorecard.LastEvaluatedAt)
+	plan.CheckCount, plan.MaxPoints, plan.LevelCount = scorecardCounts(&apiResp.Scorecard)
+}
+
+// stringOrNull checks for and handles nil strings.
+func stringOrNull(s *string) types.String {
+	if s != nil {
+		return types.StringValue(*s)
+	}
+	return types.StringNull()
+}
+
+// stringOrEmpty maps nil strings to the empty string DX stores for unset fields.
+func stringOrEmpty(s *string) types.String {
+	if s != nil {
+		return types.StringValue(*s)
+	}
+	return types.StringValue("")
+}
+
+// stringOrPrior treats nil and empty strings as equivalent, keeping whichever
+// representation was previously planned.
+func stringOrPrior(s *string, prior types.String) types.String {
+	if s != nil && *s != "" {
+		return types.StringValue(*s)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return types.StringNull()
+}
+
+// sqlOrNull and sqlOrEmpty map SQL attributes, whose semantic equality absorbs
+// the way DX reformats stored queries.
+func sqlOrNull(s *string) sqlStringValue {
+	if s != nil {
+		return newSQLStringValue(*s)
+	}
+	return newSQLStringNull()
+}
+
+func sqlOrEmpty(s *string) sqlStringValue {
+	if s != nil {
+		return newSQLStringValue(*s)
+	}
+	return newSQLStringValue("")
+}
+
+// jsonOrEmpty maps JSON attributes, whose semantic equality absorbs the way DX
+// reorders stored keys.
+func jsonOrEmpty(s *string) jsonStringValue {
+	if s != nil {
+		return newJSONStringValue(*s)
+	}
+	return newJSONStringValue("")
+}
+
+// boolApiToTF preserves the value of a bool field if it's null in the plan.
+func boolApiToTF(apiVal bool, planVal types.Bool) types.Bool {
+	if planVal.IsNull() && !apiVal {
+		return types.BoolNull()
+	}
+	return types.BoolValue(apiVal)
+}
+
+// int64OrNull checks for and handles nil ints.
+func int64OrNull(n *int) types.Int64 {
+	if n != nil {
+		return types.Int64Value(int64(*n))
+	}
+	return types.Int64Null()
+}
+
+// checkModelFromAPI maps a check returned by the API. Keys are not returned by
+// the API, so they are taken from the prior value of the check.
+func checkModelFromAPI(chk *dxapi.APICheck, prevCheck checkModel) checkModel {
+	check := checkModel{
+		Id:                  stringOrNull(chk.Id),
+		Name:                stringOrNull(chk.Name),
+		Description:         stringOrEmpty(chk.Description),
+		Ordering:            int64OrNull(chk.Ordering),
+		Sql:                 sqlOrNull(chk.Sql),
+		FilterSql:           sqlOrEmpty(chk.FilterSql),
+		FilterMessage:       stringOrEmpty(chk.FilterMessage),
+		OutputEnabled:       types.BoolValue(chk.OutputEnabled),
+		OutputType:          stringOrPrior(chk.OutputType, prevCheck.OutputType),
+		OutputAggregation:   stringOrPrior(chk.OutputAggregation, prevCheck.OutputAggregation),
+		OutputCustomOptions: jsonOrEmpty(chk.OutputCustomOptions),
+		EstimatedDevDays:    int64OrNull(chk.EstimatedDevDays),
+		ExternalUrl:         stringOrEmpty(chk.ExternalUrl),
+		Published:           types.BoolValue(chk.Published),
+		// Key not returned by API. Leave same as plan.
+		ScorecardLevelKey: prevCheck.ScorecardLevelKey,
+		// Key not returned by API. Leave same as plan.
+		ScorecardCheckGroupKey: prevCheck.ScorecardCheckGroupKey,
+		Points:                 int64OrNull(chk.Points),
+	}
+
+	// The nested level and check group are only kept in state when they
+	// were configured, since scorecard_level_key and
+	// scorecard_check_group_key already identify them.
+	if prevCheck.Level != nil && chk.Level != nil {
+		check.Level = &levelModel{
+			// Key not returned by API. Leave same as plan.
+			Key:   prevCheck.Level.Key,
+			Id:    stringOrNull(chk.Level.Id),
+			Name:  stringOrNull(chk.Level.Name),
+			Color: stringOrNull(chk.Level.Color),
+			Rank:  int64OrNull(chk.Level.Rank),
+		}
+	} else {
+		check.Level = prevCheck.Level
+	}
+	if prevCheck.CheckGroup != nil && chk.CheckGroup != nil {
+		check.CheckGroup = &checkGroupModel{
+			// Key not returned by API. Leave same as plan.
+			Key:      prevCheck.CheckGroup.Key,
+			Id:       stringOrNull(chk.CheckGroup.Id),
+			Name:     stringOrNull(chk.CheckGroup.Name),
+			Ordering: int64OrNull(chk.CheckGroup.Ordering),
+		}
+	} else {
+		check.CheckGroup = prevCheck.CheckGroup
+	}
+
+	return check
+}
+
+// scorecardCounts summarizes a scorecard. max_points only applies to POINTS
+// scorecards and level_count only to LEVEL scorecards; both are null otherwise.
+func scorecardCounts(scorecard *dxapi.APIScorecard) (checkCount, maxPoints, levelCount types.Int64) {
+	checkCount = types.Int64Value(int64(len(scorecard.Checks)))
+	maxPoints = types.Int64Null()
+	levelCount = types.Int64Null()
+
+	switch scorecard.Type {
+	case "POINTS":
+		var total int64
+		for _, check := range scorecard.Checks {
+			if check.Points != nil {
+				total += int64(*check.Points)
+			}
+		}
+		maxPoints = types.Int64Value(total)
+	case "LEVEL":
+		levelCount = types.Int64Value(int64(len(scorecard.Levels)))
+	}
+
+	return checkCount, maxPoints, levelCount
 }
 
 func (r *scorecardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -632,12 +1084,14 @@ func (r *scorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Call the API to get the latest scorecard data
 	apiResp, err := r.client.GetScorecard(ctx, id)
+	if errors.Is(err, dxapi.ErrNotFound) {
+		// The scorecard was deleted outside of Terraform, or an import named
+		// one that does not exist. Removing it from state plans its creation,
+		// or fails the import.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		// TODO - implement resource not found error handling
-		// 	// Resource no longer exists remotely — remove from state
-		// 	resp.State.RemoveResource(ctx)
-		// 	return
-		// }
 		resp.Diagnostics.AddError(
 			"Error reading scorecard",
 			fmt.Sprintf("Could not read scorecard ID %s: %s", id, err.Error()),
@@ -648,7 +1102,61 @@ func (r *scorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Map API response to Terraform state model
 	// Shallow copy of plan to preserve values
 	oldState := state
-	mapApiResponseToTerraformModel(apiResp, &state, &oldState)
+
+	// Keys are not returned by the API. Right after an import, seed them from
+	// the keys supplied in the import ID so they are mapped by position.
+	importKeys, diags := getImportKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if importKeys != nil {
+		importKeys.seed(&oldState)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importKeysPrivateStateKey, nil)...)
+	}
+
+	// Only the checks managed by Terraform are kept in state.
+	view, diags := newChecksView(ctx, state.ChecksManagement.ValueString(), req.Private, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	view = view.afterRead(apiResp.Scorecard)
+
+	view.mapResponse(apiResp, &state, &oldState)
+	state.Url = stringOrNull(r.client.ScorecardURL(state.Id.ValueString()))
+
+	// Provider-only settings are not stored in DX. Fill in their defaults when
+	// they are missing, e.g. after an import, so the next plan is clean.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(r.defaultDeletionProtection)
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyDelete)
+	}
+	if state.OverwriteRemoteChanges.IsNull() {
+		state.OverwriteRemoteChanges = types.BoolValue(false)
+	}
+	if state.PublishStrategy.IsNull() {
+		state.PublishStrategy = types.StringValue(publishImmediate)
+	}
+	if state.ChecksManagement.IsNull() {
+		state.ChecksManagement = types.StringValue(checksExclusive)
+	}
+	if state.PublishTimeout.IsNull() {
+		state.PublishTimeout = types.StringValue(defaultEvaluationTimeout)
+	}
+	if state.PublishPollInterval.IsNull() {
+		state.PublishPollInterval = types.StringValue(defaultEvaluationPollInterval)
+	}
+
+	// Remember what DX returned, so Update can detect changes made in the
+	// meantime.
+	resp.Diagnostics.Append(setRemoteVersion(ctx, resp.Private, view.scorecard(apiResp.Scorecard))...)
+	if apiResp.Scorecard.Published {
+		resp.Diagnostics.Append(setPendingPublish(ctx, resp.Private, false)...)
+	}
+	resp.Diagnostics.Append(view.save(ctx, resp.Private)...)
 	// state.Id = types.StringValue(apiResp.Scorecard.Id)
 	// state.Name = types.StringValue(apiResp.Scorecard.Name)
 	// // state.Description = types.StringValue(apiResp.Scorecard.Description)
@@ -658,8 +1166,8 @@ func (r *scorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 }
-	
 
 func (r *scorecardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan scorecardModel
@@ -668,102 +1176,66 @@ func (r *scorecardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// Build the payload, similar to Create, but include the id
-	payload := map[string]interface{}{
-		"id": plan.Id.ValueString(),
-		"name": plan.Name.ValueString(),
-		"type": plan.Type.ValueString(),
-		"entity_filter_type": plan.EntityFilterType.ValueString(),
-		"evaluation_frequency_hours": plan.EvaluationFrequency.ValueBigFloat(),
+	var state scorecardModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...) // Get the current state
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	scorecardType := plan.Type.ValueString()
-	if scorecardType == "LEVEL" {
-		payload["empty_level_label"] = plan.EmptyLevelLabel.ValueString()
-		payload["empty_level_color"] = plan.EmptyLevelColor.ValueString()
-		levels := []map[string]interface{}{}
-		for _, level := range plan.Levels {
-			levels = append(levels, map[string]interface{}{
-				"key":   level.Key.ValueString(),
-				"id":    level.Id.ValueString(),
-				"name":  level.Name.ValueString(),
-				"color": level.Color.ValueString(),
-				"rank":  level.Rank.ValueBigFloat(),
-			})
-		}
-		payload["levels"] = levels
+	// Standalone check, level and check group resources update the same
+	// scorecard, so hold its lock for the whole update.
+	unlock := scorecardLocks.lock(state.Id.ValueString())
+	defer unlock()
+
+	// The checks in state are the ones managed as of the last refresh, so
+	// they are compared in the view of the prior checks_management mode.
+	priorView, diags := newChecksView(ctx, state.ChecksManagement.ValueString(), req.Private, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if scorecardType == "POINTS" {
-		checkGroups := []map[string]interface{}{}
-		for _, group := range plan.CheckGroups {
-			checkGroups = append(checkGroups, map[string]interface{}{
-				"key":      group.Key.ValueString(),
-				"id":       group.Id.ValueString(),
-				"name":     group.Name.ValueString(),
-				"ordering": group.Ordering,
-			})
+
+	// Refuse to silently overwrite changes made in DX since the last refresh.
+	if !plan.OverwriteRemoteChanges.ValueBool() {
+		resp.Diagnostics.Append(r.checkRemoteChanges(ctx, req.Private, state, priorView)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		payload["check_groups"] = checkGroups
-	}
-	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
-		payload["description"] = plan.Description.ValueString()
 	}
-	if !plan.Published.IsNull() && !plan.Published.IsUnknown() {
-		payload["published"] = plan.Published.ValueBool()
+
+	// Only send what changed, so DX keeps the history of untouched checks.
+	payload := scorecardUpdatePayload(ctx, state, plan)
+
+	// Outside of exclusive mode, checks that Terraform does not manage are
+	// kept as they are.
+	view, diags := newChecksView(ctx, plan.ChecksManagement.ValueString(), req.Private, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if len(plan.EntityFilterTypeIdentifiers) > 0 {
-		identifiers := make([]string, 0, len(plan.EntityFilterTypeIdentifiers))
-		for _, id := range plan.EntityFilterTypeIdentifiers {
-			if !id.IsNull() && !id.IsUnknown() {
-				identifiers = append(identifiers, id.ValueString())
-			}
+	current := &dxapi.APIScorecard{}
+	if _, sendsChecks := payload["checks"]; sendsChecks && view.mode == checksAdditive {
+		currentResp, err := r.client.GetScorecard(ctx, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading scorecard", fmt.Sprintf("Could not read the current checks of scorecard ID %s: %s", state.Id.ValueString(), err.Error()))
+			return
 		}
-		payload["entity_filter_type_identifiers"] = identifiers
+		current = &currentResp.Scorecard
 	}
-	if !plan.EntityFilterSql.IsNull() && !plan.EntityFilterSql.IsUnknown() {
-		payload["entity_filter_sql"] = plan.EntityFilterSql.ValueString()
+	kept := view.mergeChecks(payload, current)
+
+	// Content changes are saved unpublished when the scorecard is only
+	// published once they are evaluated. This also covers publishing a
+	// scorecard that an earlier apply left unpublished.
+	pending, diags := getPendingPublish(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	checks := []map[string]interface{}{}
-	for _, check := range plan.Checks {
-		checkPayload := map[string]interface{}{
-			"id":                   check.Id.ValueString(),
-			"name":                 check.Name.ValueString(),
-			"description":          check.Description.ValueString(),
-			"ordering":             check.Ordering,
-			"sql":                  check.Sql.ValueString(),
-			"filter_sql":           check.FilterSql.ValueString(),
-			"filter_message":       check.FilterMessage.ValueString(),
-			"output_enabled":       check.OutputEnabled.ValueBool(),
-			"output_type":          check.OutputType.ValueString(),
-			"output_aggregation":   check.OutputAggregation.ValueString(),
-			"output_custom_options": check.OutputCustomOptions.ValueString(),
-			"estimated_dev_days":   check.EstimatedDevDays,
-			"external_url":         check.ExternalUrl.ValueString(),
-			"published":            check.Published.ValueBool(),
-		}
-		if scorecardType == "LEVEL" {
-			checkPayload["scorecard_level_key"] = check.ScorecardLevelKey.ValueString()
-			checkPayload["level"] = map[string]interface{}{
-				"key":   check.Level.Key.ValueString(),
-				"id":    check.Level.Id.ValueString(),
-				"name":  check.Level.Name.ValueString(),
-				"color": check.Level.Color.ValueString(),
-				"rank":  check.Level.Rank.ValueBigFloat(),
-			}
-		}
-		if scorecardType == "POINTS" {
-			checkPayload["scorecard_check_group_key"] = check.ScorecardCheckGroupKey.ValueString()
-			checkPayload["check_group"] = map[string]interface{}{
-				"key":      check.CheckGroup.Key.ValueString(),
-				"id":       check.CheckGroup.Id.ValueString(),
-				"name":     check.CheckGroup.Name.ValueString(),
-				"ordering": check.CheckGroup.Ordering,
-			}
-			checkPayload["points"] = check.Points
-		}
-		checks = append(checks, checkPayload)
+	publishAfterEvaluation := publishesAfterEvaluation(plan) && (changesContent(payload) || !state.Published.ValueBool() || pending)
+	if publishAfterEvaluation {
+		payload["published"] = false
 	}
-	payload["checks"] = checks
 
 	apiResp, err := r.client.UpdateScorecard(ctx, payload)
 	if err != nil {
@@ -771,13 +1243,31 @@ func (r *scorecardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	oldPlan := plan
-	mapApiResponseToTerraformModel(apiResp, &plan, &oldPlan)
+	// If publishing fails, the unpublished scorecard is still saved to state.
+	// Publishing already waits for an evaluation of the changes, and there is
+	// nothing to wait for if only Terraform settings changed.
+	if publishAfterEvaluation {
+		apiResp, diags = r.publishAfterEvaluation(ctx, plan, apiResp, false)
+		resp.Diagnostics.Append(diags...)
+	} else if plan.WaitForEvaluation != nil && changesContent(payload) {
+		apiResp, diags = r.awaitEvaluation(ctx, plan.WaitForEvaluation, apiResp, false)
+		resp.Diagnostics.Append(diags...)
+	}
 
-	// Map API response to Terraform state model
+	view = view.afterWrite(apiResp.Scorecard, kept)
+
+	oldPlan := plan
+	view.mapResponse(apiResp, &plan, &oldPlan)
+	plan.Url = stringOrNull(r.client.ScorecardURL(plan.Id.ValueString()))
+	if apiResp.Scorecard.Published || !plan.Published.ValueBool() {
+		resp.Diagnostics.Append(setPendingPublish(ctx, resp.Private, false)...)
+	}
+	resp.Diagnostics.Append(setRemoteVersion(ctx, resp.Private, view.scorecard(apiResp.Scorecard))...)
+	resp.Diagnostics.Append(view.save(ctx, resp.Private)...)
 
-	diags := resp.State.Set(ctx, plan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 func (r *scorecardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -793,6 +1283,32 @@ func (r *scorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	// Abandoning the scorecard leaves it in DX, so protection does not stop it.
+	if state.DeletionProtection.ValueBool() && state.OnDestroy.ValueString() != onDestroyAbandon {
+		resp.Diagnostics.AddError(
+			"Scorecard is protected from deletion",
+			fmt.Sprintf("Scorecard %q (ID %s) has deletion_protection enabled. Set deletion_protection = false and apply before destroying it, or set on_destroy = %q to only remove it from Terraform.",
+				state.Name.ValueString(), id, onDestroyAbandon),
+		)
+		return
+	}
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		resp.Diagnostics.AddWarning(
+			"Scorecard left in DX",
+			fmt.Sprintf("Scorecard %q (ID %s) was removed from Terraform state but not from DX because on_destroy = %q.", state.Name.ValueString(), id, onDestroyAbandon),
+		)
+		return
+
+	case onDestroyUnpublish:
+		payload := map[string]interface{}{"id": id, "published": false}
+		if _, err := r.client.UpdateScorecard(ctx, payload); err != nil {
+			resp.Diagnostics.AddError("Error unpublishing scorecard", err.Error())
+		}
+		return
+	}
+
 	success, err := r.client.DeleteScorecard(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting scorecard", err.Error())
@@ -804,7 +1320,3 @@ func (r *scorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 	// No need to set state, resource will be removed by Terraform if this method returns successfully
 }
-
-func (r *scorecardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
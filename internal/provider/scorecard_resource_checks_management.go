@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Options for checks_management.
+const (
+	checksExclusive = "exclusive"
+	checksAdditive  = "additive"
+	checksIgnore    = "ignore"
+)
+
+// managedChecksPrivateStateKey is the private state key holding the ids of the
+// checks that Terraform manages in additive mode.
+const managedChecksPrivateStateKey = "managed_checks"
+
+// checksView is the part of a scorecard's checks that Terraform manages, as
+// set by checks_management. Checks outside of it are neither kept in state
+// nor changed by updates.
+type checksView struct {
+	mode    string
+	managed map[string]bool
+}
+
+// newChecksView returns the view for a checks_management mode. In additive
+// mode, the managed checks are the ones recorded in private state, or if none
+// were recorded yet, e.g. after switching from exclusive mode, the checks in
+// the prior state.
+func newChecksView(ctx context.Context, mode string, private privateStateGetter, prior scorecardModel) (checksView, diag.Diagnostics) {
+	view := checksView{mode: mode, managed: map[string]bool{}}
+	if mode == "" {
+		view.mode = checksExclusive
+	}
+	if view.mode != checksAdditive || private == nil {
+		return view, nil
+	}
+
+	raw, diags := private.GetKey(ctx, managedChecksPrivateStateKey)
+	if diags.HasError() {
+		return view, diags
+	}
+	if len(raw) == 0 {
+		for _, check := range prior.Checks {
+			if !check.Id.IsNull() && !check.Id.IsUnknown() {
+				view.managed[check.Id.ValueString()] = true
+			}
+		}
+		return view, diags
+	}
+
+	var ids []string
+	if err := json.Unmarshal(raw, &ids); err != nil {
+		diags.AddError("Error reading managed checks", fmt.Sprintf("Could not decode the managed check ids from private state: %s", err.Error()))
+		return view, diags
+	}
+	for _, id := range ids {
+		view.managed[id] = true
+	}
+	return view, diags
+}
+
+// scorecard returns the scorecard with only the checks in the view.
+func (v checksView) scorecard(scorecard dxapi.APIScorecard) dxapi.APIScorecard {
+	switch v.mode {
+	case checksIgnore:
+		scorecard.Checks = nil
+	case checksAdditive:
+		checks := make([]*dxapi.APICheck, 0, len(scorecard.Checks))
+		for _, check := range scorecard.Checks {
+			if check.Id != nil && v.managed[*check.Id] {
+				checks = append(checks, check)
+			}
+		}
+		scorecard.Checks = checks
+	}
+	return scorecard
+}
+
+// mapResponse maps an API response to the model like
+// mapApiResponseToTerraformModel, keeping only the checks in the view. The
+// summary attributes still cover every check of the scorecard.
+func (v checksView) mapResponse(apiResp *dxapi.APIResponse, model, prior *scorecardModel) {
+	if v.mode == checksExclusive {
+		mapApiResponseToTerraformModel(apiResp, model, prior)
+		return
+	}
+
+	visible := dxapi.APIResponse{Ok: apiResp.Ok, Scorecard: v.scorecard(apiResp.Scorecard)}
+	mapApiResponseToTerraformModel(&visible, model, prior)
+	if len(visible.Scorecard.Checks) == 0 && len(prior.Checks) > 0 {
+		// The managed checks were all removed in DX.
+		model.Checks = nil
+	}
+	model.CheckCount, model.MaxPoints, model.LevelCount = scorecardCounts(&apiResp.Scorecard)
+}
+
+// mergeChecks merges the checks of an update payload into the current checks
+// of the scorecard. Checks outside of the view are kept as references; in
+// ignore mode, checks are not sent at all. It returns the ids of the checks
+// that were kept, so the managed checks can be told apart in the response, or
+// nil if no checks are sent.
+func (v checksView) mergeChecks(payload map[string]interface{}, current *dxapi.APIScorecard) map[string]bool {
+	checks, ok := payload["checks"].([]map[string]interface{})
+	if !ok || v.mode == checksIgnore {
+		delete(payload, "checks")
+		return nil
+	}
+
+	kept := map[string]bool{}
+	if v.mode == checksAdditive {
+		merged := make([]map[string]interface{}, 0, len(current.Checks)+len(checks))
+		for _, check := range current.Checks {
+			if check.Id != nil && !v.managed[*check.Id] {
+				kept[*check.Id] = true
+				merged = append(merged, map[string]interface{}{"id": *check.Id})
+			}
+		}
+		payload["checks"] = append(merged, checks...)
+	}
+	return kept
+}
+
+// afterWrite returns the view after a scorecard was written. In additive mode,
+// every check of the written scorecard except the ones that were kept is
+// managed from now on, which includes the checks that were just created. If
+// no checks were sent, kept is nil and the managed checks stay the same.
+func (v checksView) afterWrite(written dxapi.APIScorecard, kept map[string]bool) checksView {
+	if v.mode != checksAdditive {
+		return v
+	}
+	if kept == nil {
+		return v.afterRead(written)
+	}
+
+	managed := map[string]bool{}
+	for _, check := range written.Checks {
+		if check.Id != nil && !kept[*check.Id] {
+			managed[*check.Id] = true
+		}
+	}
+	return checksView{mode: v.mode, managed: managed}
+}
+
+// afterRead returns the view after a scorecard was read, dropping managed
+// checks that were removed in DX.
+func (v checksView) afterRead(read dxapi.APIScorecard) checksView {
+	if v.mode != checksAdditive {
+		return v
+	}
+
+	managed := map[string]bool{}
+	for _, check := range read.Checks {
+		if check.Id != nil && v.managed[*check.Id] {
+			managed[*check.Id] = true
+		}
+	}
+	return checksView{mode: v.mode, managed: managed}
+}
+
+// save records the managed checks in private state. Outside of additive mode
+// nothing is recorded, so switching to additive mode later starts from the
+// checks in state.
+func (v checksView) save(ctx context.Context, private privateStateSetter) diag.Diagnostics {
+	if v.mode != checksAdditive {
+		return private.SetKey(ctx, managedChecksPrivateStateKey, nil)
+	}
+
+	ids := make([]string, 0, len(v.managed))
+	for id := range v.managed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var diags diag.Diagnostics
+	raw, err := json.Marshal(ids)
+	if err != nil {
+		diags.AddError("Error recording managed checks", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, managedChecksPrivateStateKey, raw)
+}
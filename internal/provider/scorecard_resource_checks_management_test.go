@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+)
+
+func TestChecksViewMergeChecks(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	current := &dxapi.APIScorecard{
+		Checks: []*dxapi.APICheck{
+			{Id: str("c1")},
+			{Id: str("ui")},
+			{Id: str("c2")},
+		},
+	}
+	managed := map[string]bool{"c1": true, "c2": true}
+
+	testCases := map[string]struct {
+		mode     string
+		payload  map[string]interface{}
+		expected []interface{}
+		kept     map[string]bool
+	}{
+		"exclusive replaces the checks": {
+			mode:     checksExclusive,
+			payload:  map[string]interface{}{"checks": []map[string]interface{}{{"id": "c1"}}},
+			expected: []interface{}{"c1"},
+			kept:     map[string]bool{},
+		},
+		"additive keeps unmanaged checks": {
+			mode:     checksAdditive,
+			payload:  map[string]interface{}{"checks": []map[string]interface{}{{"id": "c1"}, {"name": "New"}}},
+			expected: []interface{}{"ui", "c1", nil},
+			kept:     map[string]bool{"ui": true},
+		},
+		"additive without checks": {
+			mode:    checksAdditive,
+			payload: map[string]interface{}{"name": "Renamed"},
+		},
+		"ignore never sends checks": {
+			mode:    checksIgnore,
+			payload: map[string]interface{}{"checks": []map[string]interface{}{}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			view := checksView{mode: testCase.mode, managed: managed}
+			kept := view.mergeChecks(testCase.payload, current)
+			if !reflect.DeepEqual(kept, testCase.kept) {
+				t.Errorf("expected kept checks %v, got %v", testCase.kept, kept)
+			}
+
+			checks, ok := testCase.payload["checks"].([]map[string]interface{})
+			if ok != (testCase.expected != nil) {
+				t.Fatalf("expected checks in payload: %t, got: %v", testCase.expected != nil, testCase.payload)
+			}
+			var ids []interface{}
+			for _, check := range checks {
+				ids = append(ids, check["id"])
+			}
+			if !reflect.DeepEqual(ids, testCase.expected) {
+				t.Errorf("expected checks %v, got %v", testCase.expected, ids)
+			}
+		})
+	}
+}
+
+func TestChecksViewAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	written := dxapi.APIScorecard{
+		Checks: []*dxapi.APICheck{
+			{Id: str("ui")},
+			{Id: str("c1")},
+			{Id: str("new")},
+		},
+	}
+	view := checksView{mode: checksAdditive, managed: map[string]bool{"c1": true, "gone": true}}
+
+	merged := view.afterWrite(written, map[string]bool{"ui": true})
+	if expected := map[string]bool{"c1": true, "new": true}; !reflect.DeepEqual(merged.managed, expected) {
+		t.Errorf("expected managed checks %v after sending checks, got %v", expected, merged.managed)
+	}
+
+	unchanged := view.afterWrite(written, nil)
+	if expected := map[string]bool{"c1": true}; !reflect.DeepEqual(unchanged.managed, expected) {
+		t.Errorf("expected managed checks %v without sending checks, got %v", expected, unchanged.managed)
+	}
+
+	visible := merged.scorecard(written)
+	if len(visible.Checks) != 2 || *visible.Checks[0].Id != "c1" {
+		t.Errorf("expected only the managed checks to be visible, got %d checks", len(visible.Checks))
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// seedClonedContent fills the levels, check groups and checks that are not
+// configured from the scorecard set in clone_from_scorecard_id. Configured
+// lists override the cloned ones. It runs when the scorecard is planned, and
+// again on create if the source scorecard id was not known at plan time.
+func (r *scorecardResource) seedClonedContent(ctx context.Context, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var sourceId types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("clone_from_scorecard_id"), &sourceId)...)
+	if diags.HasError() || sourceId.IsNull() || sourceId.IsUnknown() {
+		return diags
+	}
+
+	// Only lists left unknown by clonedFromSource are seeded.
+	unseeded := map[string]bool{}
+	for _, attribute := range []string{"levels", "check_groups", "checks"} {
+		var list types.List
+		diags.Append(plan.GetAttribute(ctx, path.Root(attribute), &list)...)
+		if diags.HasError() {
+			return diags
+		}
+		unseeded[attribute] = list.IsUnknown()
+	}
+	if !unseeded["levels"] && !unseeded["check_groups"] && !unseeded["checks"] {
+		return diags
+	}
+
+	if r.client == nil {
+		// The provider is not configured yet, the lists are seeded on create.
+		return diags
+	}
+
+	source, err := r.client.GetScorecard(ctx, sourceId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("clone_from_scorecard_id"),
+			"Error reading source scorecard",
+			fmt.Sprintf("Could not read scorecard ID %s to clone: %s", sourceId.ValueString(), err.Error()),
+		)
+		return diags
+	}
+
+	scorecardType, known, d := plannedType(ctx, *plan)
+	diags.Append(d...)
+	if known && scorecardType != source.Scorecard.Type {
+		diags.AddAttributeError(
+			path.Root("clone_from_scorecard_id"),
+			"Cannot clone scorecard",
+			fmt.Sprintf("Scorecard %q is a %s scorecard and cannot be cloned into a %s scorecard.", source.Scorecard.Name, source.Scorecard.Type, scorecardType),
+		)
+		return diags
+	}
+
+	levels, groups, checks := clonedContent(&source.Scorecard)
+	if unseeded["levels"] {
+		diags.Append(plan.SetAttribute(ctx, path.Root("levels"), levels)...)
+	}
+	if unseeded["check_groups"] {
+		diags.Append(plan.SetAttribute(ctx, path.Root("check_groups"), groups)...)
+	}
+	if unseeded["checks"] {
+		diags.Append(plan.SetAttribute(ctx, path.Root("checks"), checks)...)
+	}
+	return diags
+}
+
+// clonedContent returns the levels, check groups and checks of a scorecard as
+// new elements of another scorecard. Keys are derived from the names, the same
+// way as on import, and ids are left to be assigned by DX.
+func clonedContent(source *dxapi.APIScorecard) (levels []levelModel, groups []checkGroupModel, checks []checkModel) {
+	var cloned scorecardModel
+	mapApiResponseToTerraformModel(&dxapi.APIResponse{Scorecard: *source}, &cloned, &scorecardModel{})
+
+	for _, level := range cloned.Levels {
+		level.Id = types.StringUnknown()
+		levels = append(levels, level)
+	}
+	for _, group := range cloned.CheckGroups {
+		group.Id = types.StringUnknown()
+		groups = append(groups, group)
+	}
+	for _, check := range cloned.Checks {
+		check.Id = types.StringUnknown()
+		checks = append(checks, check)
+	}
+	return levels, groups, checks
+}
+
+// clonedFromSource plans the levels, check groups or checks of a scorecard
+// that are not configured. Without clone_from_scorecard_id they are null, as
+// if the attribute were not computed. With it, they are left unknown on create
+// so that seedClonedContent fills them, and keep their state afterwards, so
+// the cloned content stays managed without being configured.
+func clonedFromSource() planmodifier.List {
+	return clonedFromSourceModifier{}
+}
+
+type clonedFromSourceModifier struct{}
+
+func (m clonedFromSourceModifier) Description(_ context.Context) string {
+	return "Defaults to the content of the scorecard set in clone_from_scorecard_id when the scorecard is created."
+}
+
+func (m clonedFromSourceModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m clonedFromSourceModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	// Nothing to plan on destroy, and configured lists override the clone.
+	if req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var sourceId types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("clone_from_scorecard_id"), &sourceId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checks are not planned at all when Terraform ignores them.
+	var checksManagement types.String
+	if req.Path.Equal(path.Root("checks")) {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("checks_management"), &checksManagement)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	switch {
+	case sourceId.IsNull() || checksManagement.ValueString() == checksIgnore:
+		resp.PlanValue = types.ListNull(req.PlanValue.ElementType(ctx))
+	case !req.State.Raw.IsNull():
+		resp.PlanValue = req.StateValue
+	default:
+		resp.PlanValue = types.ListUnknown(req.PlanValue.ElementType(ctx))
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+)
+
+func TestClonedContent(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+	source := &dxapi.APIScorecard{
+		Id:   "golden",
+		Name: "Production Readiness",
+		Type: "LEVEL",
+		Levels: []*dxapi.APILevel{
+			{Id: str("l1"), Key: str("bronze-level"), Name: str("Bronze"), Color: str("#cd7f32"), Rank: num(1)},
+			{Id: str("l2"), Name: str("Gold Tier"), Color: str("#ffd700"), Rank: num(2)},
+		},
+		Checks: []*dxapi.APICheck{
+			{
+				Id:       str("c1"),
+				Name:     str("Has owner"),
+				Sql:      str("select 1"),
+				Ordering: num(0),
+				Level:    &dxapi.APILevel{Id: str("l2"), Name: str("Gold Tier")},
+			},
+		},
+	}
+
+	levels, groups, checks := clonedContent(source)
+
+	if len(levels) != 2 || len(groups) != 0 || len(checks) != 1 {
+		t.Fatalf("expected 2 levels, 0 check groups and 1 check, got %d, %d and %d", len(levels), len(groups), len(checks))
+	}
+	if got := levels[0].Key.ValueString(); got != "bronze" {
+		t.Errorf("expected a key derived from the name, got %q", got)
+	}
+	if got := levels[1].Rank.ValueInt64(); got != 2 {
+		t.Errorf("expected the rank to be copied, got %d", got)
+	}
+	for _, level := range levels {
+		if !level.Id.IsUnknown() {
+			t.Errorf("expected level %s to get a new id, got %s", level.Name, level.Id)
+		}
+	}
+
+	check := checks[0]
+	if !check.Id.IsUnknown() {
+		t.Errorf("expected the check to get a new id, got %s", check.Id)
+	}
+	if got := check.ScorecardLevelKey.ValueString(); got != "gold-tier" {
+		t.Errorf("expected the check to reference level %q, got %q", "gold-tier", got)
+	}
+	if got := check.Sql.ValueString(); got != "select 1" {
+		t.Errorf("expected the SQL to be copied, got %q", got)
+	}
+}
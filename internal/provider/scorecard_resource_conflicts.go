@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// remoteVersionPrivateStateKey is the private state key holding the version of
+// the scorecard last seen in DX.
+const remoteVersionPrivateStateKey = "remote_version"
+
+// remoteVersion identifies the content of a scorecard as last seen in DX.
+type remoteVersion struct {
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Hash      string `json:"hash"`
+}
+
+// newRemoteVersion computes the version of a scorecard. The hash covers the
+// scorecard content only, so read-only metadata does not cause false conflicts.
+func newRemoteVersion(scorecard dxapi.APIScorecard) (remoteVersion, error) {
+	var version remoteVersion
+	if scorecard.UpdatedAt != nil {
+		version.UpdatedAt = *scorecard.UpdatedAt
+	}
+
+	scorecard.CreatedAt = nil
+	scorecard.UpdatedAt = nil
+	scorecard.LastEvaluatedAt = nil
+	content, err := json.Marshal(scorecard)
+	if err != nil {
+		return version, fmt.Errorf("encoding scorecard: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	version.Hash = hex.EncodeToString(sum[:])
+
+	return version, nil
+}
+
+// privateStateSetter is satisfied by the private state of framework responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setRemoteVersion records the version of the scorecard in private state.
+func setRemoteVersion(ctx context.Context, private privateStateSetter, scorecard dxapi.APIScorecard) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	version, err := newRemoteVersion(scorecard)
+	if err != nil {
+		diags.AddError("Error recording scorecard version", err.Error())
+		return diags
+	}
+	raw, err := json.Marshal(version)
+	if err != nil {
+		diags.AddError("Error recording scorecard version", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, remoteVersionPrivateStateKey, raw)
+}
+
+// getRemoteVersion returns the recorded version of the scorecard, or nil if
+// none was recorded yet.
+func getRemoteVersion(ctx context.Context, private privateStateGetter) (*remoteVersion, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, remoteVersionPrivateStateKey)
+	if diags.HasError() || len(raw) == 0 {
+		return nil, diags
+	}
+
+	var version remoteVersion
+	if err := json.Unmarshal(raw, &version); err != nil {
+		diags.AddError("Error reading scorecard version", fmt.Sprintf("Could not decode the recorded scorecard version from private state: %s", err.Error()))
+		return nil, diags
+	}
+	return &version, diags
+}
+
+// checkRemoteChanges fetches the scorecard and reports an error if the part of
+// it that Terraform manages changed in DX since it was last read.
+func (r *scorecardResource) checkRemoteChanges(ctx context.Context, private privateStateGetter, state scorecardModel, view checksView) diag.Diagnostics {
+	recorded, diags := getRemoteVersion(ctx, private)
+	if diags.HasError() || recorded == nil {
+		return diags
+	}
+
+	apiResp, err := r.client.GetScorecard(ctx, state.Id.ValueString())
+	if err != nil {
+		diags.AddError("Error reading scorecard", fmt.Sprintf("Could not check scorecard ID %s for remote changes: %s", state.Id.ValueString(), err.Error()))
+		return diags
+	}
+
+	current, err := newRemoteVersion(view.scorecard(apiResp.Scorecard))
+	if err != nil {
+		diags.AddError("Error reading scorecard", err.Error())
+		return diags
+	}
+	if current.Hash == recorded.Hash {
+		return diags
+	}
+
+	remote := state
+	prior := state
+	view.mapResponse(apiResp, &remote, &prior)
+
+	changes := describeRemoteChanges(ctx, state, remote)
+	if len(changes) == 0 {
+		changes = []string{"  (the changes are not represented in Terraform attributes)"}
+	}
+
+	detail := fmt.Sprintf("Scorecard %q (ID %s) was changed in DX after Terraform last read it", state.Name.ValueString(), state.Id.ValueString())
+	if current.UpdatedAt != "" {
+		detail += fmt.Sprintf(" (last updated at %s)", current.UpdatedAt)
+	}
+	detail += ". Applying now would overwrite these remote changes:\n\n" + strings.Join(changes, "\n") +
+		"\n\nRun a new plan to review the changes, or set overwrite_remote_changes = true to overwrite them."
+
+	diags.AddError("Scorecard changed outside of Terraform", detail)
+	return diags
+}
+
+// describeRemoteChanges lists the differences between the state Terraform
+// planned against and the scorecard currently in DX.
+func describeRemoteChanges(ctx context.Context, state, remote scorecardModel) []string {
+	var changes []string
+	compare := func(name string, before, after attr.Value) {
+		if !semanticallyEqual(ctx, before, after) {
+			changes = append(changes, fmt.Sprintf("  - %s: %s -> %s", name, before, after))
+		}
+	}
+
+	compare("name", state.Name, remote.Name)
+	compare("description", state.Description, remote.Description)
+	compare("published", state.Published, remote.Published)
+	compare("entity_filter_type", state.EntityFilterType, remote.EntityFilterType)
+	compare("entity_filter_sql", state.EntityFilterSql, remote.EntityFilterSql)
+	compare("evaluation_frequency_hours", state.EvaluationFrequency, remote.EvaluationFrequency)
+	compare("empty_level_label", state.EmptyLevelLabel, remote.EmptyLevelLabel)
+	compare("empty_level_color", state.EmptyLevelColor, remote.EmptyLevelColor)
+
+	levelNames := func(levels []levelModel) string {
+		names := make([]string, len(levels))
+		for i, level := range levels {
+			names[i] = level.Name.ValueString()
+		}
+		return strings.Join(names, ", ")
+	}
+	if before, after := levelNames(state.Levels), levelNames(remote.Levels); before != after {
+		changes = append(changes, fmt.Sprintf("  - levels: [%s] -> [%s]", before, after))
+	}
+
+	groupNames := func(groups []checkGroupModel) string {
+		names := make([]string, len(groups))
+		for i, group := range groups {
+			names[i] = group.Name.ValueString()
+		}
+		return strings.Join(names, ", ")
+	}
+	if before, after := groupNames(state.CheckGroups), groupNames(remote.CheckGroups); before != after {
+		changes = append(changes, fmt.Sprintf("  - check_groups: [%s] -> [%s]", before, after))
+	}
+
+	stateChecks := make(map[string]checkModel, len(state.Checks))
+	for _, check := range state.Checks {
+		stateChecks[check.Id.ValueString()] = check
+	}
+	remoteIds := make(map[string]bool, len(remote.Checks))
+	for _, check := range remote.Checks {
+		remoteIds[check.Id.ValueString()] = true
+
+		before, ok := stateChecks[check.Id.ValueString()]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("  - check %q was added", check.Name.ValueString()))
+			continue
+		}
+		if fields := changedCheckFields(ctx, before, check); len(fields) > 0 {
+			changes = append(changes, fmt.Sprintf("  - check %q changed: %s", before.Name.ValueString(), strings.Join(fields, ", ")))
+		}
+	}
+	for _, check := range state.Checks {
+		if !remoteIds[check.Id.ValueString()] {
+			changes = append(changes, fmt.Sprintf("  - check %q was removed", check.Name.ValueString()))
+		}
+	}
+
+	return changes
+}
+
+// changedCheckFields returns the names of the check attributes that differ.
+func changedCheckFields(ctx context.Context, before, after checkModel) []string {
+	var fields []string
+	for _, field := range []struct {
+		name          string
+		before, after attr.Value
+	}{
+		{"name", before.Name, after.Name},
+		{"description", before.Description, after.Description},
+		{"ordering", before.Ordering, after.Ordering},
+		{"sql", before.Sql, after.Sql},
+		{"filter_sql", before.FilterSql, after.FilterSql},
+		{"filter_message", before.FilterMessage, after.FilterMessage},
+		{"output_enabled", before.OutputEnabled, after.OutputEnabled},
+		{"output_type", before.OutputType, after.OutputType},
+		{"output_aggregation", before.OutputAggregation, after.OutputAggregation},
+		{"output_custom_options", before.OutputCustomOptions, after.OutputCustomOptions},
+		{"estimated_dev_days", before.EstimatedDevDays, after.EstimatedDevDays},
+		{"external_url", before.ExternalUrl, after.ExternalUrl},
+		{"published", before.Published, after.Published},
+		{"points", before.Points, after.Points},
+	} {
+		if !semanticallyEqual(ctx, field.before, field.after) {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
+
+// semanticallyEqual compares two values, using semantic equality for the
+// custom string types so that reformatting by DX is not reported as a change.
+func semanticallyEqual(ctx context.Context, before, after attr.Value) bool {
+	if before.Equal(after) {
+		return true
+	}
+	if before.IsNull() || after.IsNull() || before.IsUnknown() || after.IsUnknown() {
+		return false
+	}
+
+	valuable, ok := before.(basetypes.StringValuableWithSemanticEquals)
+	if !ok {
+		return false
+	}
+	afterValuable, ok := after.(basetypes.StringValuable)
+	if !ok {
+		return false
+	}
+	equal, diags := valuable.StringSemanticEquals(ctx, afterValuable)
+	return equal && !diags.HasError()
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDescribeRemoteChanges(t *testing.T) {
+	t.Parallel()
+
+	check := func(id, name, sql string) checkModel {
+		return checkModel{
+			Id:   types.StringValue(id),
+			Name: types.StringValue(name),
+			Sql:  newSQLStringValue(sql),
+		}
+	}
+
+	state := scorecardModel{
+		Name:        types.StringValue("Production Readiness"),
+		Description: types.StringValue("Services"),
+		Checks: []checkModel{
+			check("1", "Has owner", "SELECT 1\n"),
+			check("2", "Has runbook", "SELECT 2"),
+			check("3", "Has alerts", "SELECT 3"),
+		},
+	}
+	remote := scorecardModel{
+		Name:        types.StringValue("Production Readiness"),
+		Description: types.StringValue("All services"),
+		Checks: []checkModel{
+			// Only reformatted by DX.
+			check("1", "Has owner", "select 1"),
+			check("2", "Has a runbook", "SELECT 22"),
+			check("4", "Has dashboards", "SELECT 4"),
+		},
+	}
+
+	expected := []string{
+		`  - description: "Services" -> "All services"`,
+		`  - check "Has runbook" changed: name, sql`,
+		`  - check "Has dashboards" was added`,
+		`  - check "Has alerts" was removed`,
+	}
+
+	got := describeRemoteChanges(context.Background(), state, remote)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestNewRemoteVersionIgnoresMetadata(t *testing.T) {
+	t.Parallel()
+
+	before, after := "2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"
+	scorecard := dxapi.APIScorecard{Id: "1", Name: "Production Readiness", UpdatedAt: &before}
+
+	first, err := newRemoteVersion(scorecard)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	scorecard.UpdatedAt = &after
+	second, err := newRemoteVersion(scorecard)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if first.Hash != second.Hash {
+		t.Errorf("expected metadata changes to keep the hash, got %s and %s", first.Hash, second.Hash)
+	}
+
+	scorecard.Name = "Production Readiness v2"
+	third, err := newRemoteVersion(scorecard)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if first.Hash == third.Hash {
+		t.Errorf("expected content changes to change the hash")
+	}
+}
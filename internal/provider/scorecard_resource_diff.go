@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scorecardUpdatePayload builds a partial update payload containing only what
+// differs between the prior state and the plan. DX treats every check it
+// receives in full as modified, which resets its evaluation history, so
+// unchanged checks, levels and check groups are sent as id references.
+//
+// Computed ids are unknown in the plan whenever a list changes, so list
+// elements are matched to the prior state by identity rather than position:
+// see matchElements.
+func scorecardUpdatePayload(ctx context.Context, state, plan scorecardModel) map[string]interface{} {
+	scorecardType := plan.Type.ValueString()
+	payload := map[string]interface{}{
+		"id": state.Id.ValueString(),
+	}
+
+	setIfChanged := func(key string, before, after attr.Value, value interface{}) {
+		if semanticallyEqual(ctx, before, after) {
+			return
+		}
+		if after.IsNull() {
+			// Explicitly clear the field rather than leaving it untouched.
+			value = nil
+		}
+		payload[key] = value
+	}
+
+	setIfChanged("name", state.Name, plan.Name, plan.Name.ValueString())
+	setIfChanged("entity_filter_type", state.EntityFilterType, plan.EntityFilterType, plan.EntityFilterType.ValueString())
+	setIfChanged("evaluation_frequency_hours", state.EvaluationFrequency, plan.EvaluationFrequency, plan.EvaluationFrequency.ValueInt64())
+	setIfChanged("description", state.Description, plan.Description, plan.Description.ValueString())
+	setIfChanged("published", state.Published, plan.Published, plan.Published.ValueBool())
+	setIfChanged("entity_filter_sql", state.EntityFilterSql, plan.EntityFilterSql, plan.EntityFilterSql.ValueString())
+	if scorecardType == "LEVEL" {
+		setIfChanged("empty_level_label", state.EmptyLevelLabel, plan.EmptyLevelLabel, plan.EmptyLevelLabel.ValueString())
+		setIfChanged("empty_level_color", state.EmptyLevelColor, plan.EmptyLevelColor, plan.EmptyLevelColor.ValueString())
+	}
+
+	if !stringsEqual(state.EntityFilterTypeIdentifiers, plan.EntityFilterTypeIdentifiers) {
+		identifiers := make([]string, 0, len(plan.EntityFilterTypeIdentifiers))
+		for _, id := range plan.EntityFilterTypeIdentifiers {
+			if !id.IsNull() && !id.IsUnknown() {
+				identifiers = append(identifiers, id.ValueString())
+			}
+		}
+		payload["entity_filter_type_identifiers"] = identifiers
+	}
+
+	checks, checksChanged := checksUpdatePayload(ctx, state.Checks, plan.Checks, scorecardType)
+	if checksChanged {
+		payload["checks"] = checks
+	}
+
+	// Checks reference levels and check groups by key, and DX resolves those
+	// keys against the levels and check groups in the same request. Whenever
+	// checks are sent, the levels or check groups are sent too, at least as
+	// references.
+	switch scorecardType {
+	case "LEVEL":
+		levels, levelsChanged := levelsUpdatePayload(ctx, state.Levels, plan.Levels)
+		if levelsChanged || checksChanged {
+			payload["levels"] = levels
+		}
+	case "POINTS":
+		groups, groupsChanged := checkGroupsUpdatePayload(ctx, state.CheckGroups, plan.CheckGroups)
+		if groupsChanged || checksChanged {
+			payload["check_groups"] = groups
+		}
+	}
+
+	return payload
+}
+
+// levelsUpdatePayload returns the levels to send and whether any were added,
+// removed or changed. Unchanged levels are sent as id and key references.
+func levelsUpdatePayload(ctx context.Context, prior, planned []levelModel) ([]map[string]interface{}, bool) {
+	matches := matchElements(prior, planned, levelIdentities...)
+	changed := len(prior) != len(planned) || reordered(matches)
+	levels := make([]map[string]interface{}, 0, len(planned))
+
+	for i, level := range planned {
+		j := matches[i]
+		if j >= 0 && levelUnchanged(ctx, prior[j], level) {
+			levels = append(levels, map[string]interface{}{
+				"id":  prior[j].Id.ValueString(),
+				"key": level.Key.ValueString(),
+			})
+			continue
+		}
+
+		changed = true
+		levelPayload := map[string]interface{}{
+			"key":   level.Key.ValueString(),
+			"name":  level.Name.ValueString(),
+			"color": level.Color.ValueString(),
+			"rank":  level.Rank.ValueInt64(),
+		}
+		if j >= 0 {
+			setIfKnown(levelPayload, "id", prior[j].Id)
+		}
+		levels = append(levels, levelPayload)
+	}
+
+	return levels, changed
+}
+
+func levelUnchanged(ctx context.Context, before, after levelModel) bool {
+	return semanticallyEqual(ctx, before.Key, after.Key) &&
+		semanticallyEqual(ctx, before.Name, after.Name) &&
+		semanticallyEqual(ctx, before.Color, after.Color) &&
+		semanticallyEqual(ctx, before.Rank, after.Rank)
+}
+
+// checkGroupsUpdatePayload returns the check groups to send and whether any
+// were added, removed or changed. Unchanged groups are sent as id and key
+// references.
+func checkGroupsUpdatePayload(ctx context.Context, prior, planned []checkGroupModel) ([]map[string]interface{}, bool) {
+	matches := matchElements(prior, planned, checkGroupIdentities...)
+	changed := len(prior) != len(planned) || reordered(matches)
+	groups := make([]map[string]interface{}, 0, len(planned))
+
+	for i, group := range planned {
+		j := matches[i]
+		if j >= 0 && checkGroupUnchanged(ctx, prior[j], group) {
+			groups = append(groups, map[string]interface{}{
+				"id":  prior[j].Id.ValueString(),
+				"key": group.Key.ValueString(),
+			})
+			continue
+		}
+
+		changed = true
+		groupPayload := map[string]interface{}{
+			"key":      group.Key.ValueString(),
+			"name":     group.Name.ValueString(),
+			"ordering": group.Ordering.ValueInt64(),
+		}
+		if j >= 0 {
+			setIfKnown(groupPayload, "id", prior[j].Id)
+		}
+		groups = append(groups, groupPayload)
+	}
+
+	return groups, changed
+}
+
+func checkGroupUnchanged(ctx context.Context, before, after checkGroupModel) bool {
+	return semanticallyEqual(ctx, before.Key, after.Key) &&
+		semanticallyEqual(ctx, before.Name, after.Name) &&
+		semanticallyEqual(ctx, before.Ordering, after.Ordering)
+}
+
+// checksUpdatePayload returns the checks to send and whether any were added,
+// removed or changed. Unchanged checks are sent as id references and removed
+// checks are left out of the list.
+func checksUpdatePayload(ctx context.Context, prior, planned []checkModel, scorecardType string) ([]map[string]interface{}, bool) {
+	matches := matchElements(prior, planned, checkIdentities...)
+	changed := len(prior) != len(planned) || reordered(matches)
+	checks := make([]map[string]interface{}, 0, len(planned))
+
+	for i, check := range planned {
+		j := matches[i]
+		if j >= 0 && checkUnchanged(ctx, prior[j], check) {
+			checks = append(checks, map[string]interface{}{
+				"id": prior[j].Id.ValueString(),
+			})
+			continue
+		}
+
+		changed = true
+		// Keep the id of the check this one stands for, so DX updates it in
+		// place instead of replacing it. New checks are sent without an id.
+		check.Id = types.StringNull()
+		if j >= 0 {
+			check.Id = prior[j].Id
+		}
+		checks = append(checks, checkPayload(check, scorecardType))
+	}
+
+	return checks, changed
+}
+
+func checkUnchanged(ctx context.Context, before, after checkModel) bool {
+	return len(changedCheckFields(ctx, before, after)) == 0 &&
+		semanticallyEqual(ctx, before.ScorecardLevelKey, after.ScorecardLevelKey) &&
+		semanticallyEqual(ctx, before.ScorecardCheckGroupKey, after.ScorecardCheckGroupKey) &&
+		(before.Level == nil) == (after.Level == nil) &&
+		(before.Level == nil || levelUnchanged(ctx, *before.Level, *after.Level)) &&
+		(before.CheckGroup == nil) == (after.CheckGroup == nil) &&
+		(before.CheckGroup == nil || checkGroupUnchanged(ctx, *before.CheckGroup, *after.CheckGroup))
+}
+
+// Identities of list elements, in the order matchElements tries them. Levels
+// and check groups are identified by their key, checks by their id, which is
+// only known in the plan while the list is unchanged. Every kind of element
+// falls back to its name.
+var (
+	levelIdentities = []func(levelModel) types.String{
+		func(level levelModel) types.String { return level.Key },
+		func(level levelModel) types.String { return level.Name },
+	}
+	checkGroupIdentities = []func(checkGroupModel) types.String{
+		func(group checkGroupModel) types.String { return group.Key },
+		func(group checkGroupModel) types.String { return group.Name },
+	}
+	checkIdentities = []func(checkModel) types.String{
+		func(check checkModel) types.String { return check.Id },
+		func(check checkModel) types.String { return check.Name },
+	}
+)
+
+// matchElements pairs each planned list element with the prior element it
+// stands for, returning the index of that prior element, or -1 for a new
+// element. Each identity is tried for every element before falling back to the
+// next one, so an element that keeps its key is not claimed by another element
+// that took over its name. Null and unknown identities never match. Elements
+// left over after that, such as a renamed check, are paired with the prior
+// element at the same position if no other element claimed it, so that they
+// are updated in place rather than replaced.
+func matchElements[T any](prior, planned []T, identities ...func(T) types.String) []int {
+	matches := make([]int, len(planned))
+	for i := range matches {
+		matches[i] = -1
+	}
+	claimed := make([]bool, len(prior))
+
+	for _, identity := range identities {
+		for i, element := range planned {
+			if matches[i] >= 0 {
+				continue
+			}
+			id := identity(element)
+			if id.IsNull() || id.IsUnknown() {
+				continue
+			}
+			for j := range prior {
+				if !claimed[j] && identity(prior[j]).Equal(id) {
+					matches[i], claimed[j] = j, true
+					break
+				}
+			}
+		}
+	}
+
+	for i := range matches {
+		if matches[i] < 0 && i < len(prior) && !claimed[i] {
+			matches[i], claimed[i] = i, true
+		}
+	}
+
+	return matches
+}
+
+// unmatchedElements returns the prior elements that no planned element stands
+// for, along with their indices in the prior list.
+func unmatchedElements[T any](prior []T, matches []int) ([]T, []int) {
+	claimed := make([]bool, len(prior))
+	for _, j := range matches {
+		if j >= 0 {
+			claimed[j] = true
+		}
+	}
+
+	var removed []T
+	var indices []int
+	for j := range prior {
+		if !claimed[j] {
+			removed = append(removed, prior[j])
+			indices = append(indices, j)
+		}
+	}
+	return removed, indices
+}
+
+// reordered reports whether the matched elements changed places.
+func reordered(matches []int) bool {
+	last := -1
+	for _, j := range matches {
+		if j < 0 {
+			continue
+		}
+		if j < last {
+			return true
+		}
+		last = j
+	}
+	return false
+}
+
+// stringsEqual reports whether two lists of strings hold the same values in the
+// same order.
+func stringsEqual(a, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScorecardUpdatePayload(t *testing.T) {
+	t.Parallel()
+
+	level := func(id, key, name string, rank int64) levelModel {
+		return levelModel{
+			Id:    types.StringValue(id),
+			Key:   types.StringValue(key),
+			Name:  types.StringValue(name),
+			Color: types.StringValue("#cccccc"),
+			Rank:  types.Int64Value(rank),
+		}
+	}
+	check := func(id, name, sql string, ordering int64) checkModel {
+		return checkModel{
+			Id:                  types.StringValue(id),
+			Name:                types.StringValue(name),
+			Description:         types.StringValue(""),
+			Ordering:            types.Int64Value(ordering),
+			Sql:                 newSQLStringValue(sql),
+			FilterSql:           newSQLStringValue(""),
+			FilterMessage:       types.StringValue(""),
+			OutputEnabled:       types.BoolValue(false),
+			OutputType:          types.StringNull(),
+			OutputAggregation:   types.StringNull(),
+			OutputCustomOptions: newJSONStringValue(""),
+			EstimatedDevDays:    types.Int64Null(),
+			ExternalUrl:         types.StringValue(""),
+			Published:           types.BoolValue(true),
+			ScorecardLevelKey:   types.StringValue("bronze"),
+			Points:              types.Int64Null(),
+		}
+	}
+	// unknownIds mimics a plan, in which computed ids of changed lists are
+	// unknown.
+	unknownIds := func(checks ...checkModel) []checkModel {
+		for i := range checks {
+			checks[i].Id = types.StringUnknown()
+		}
+		return checks
+	}
+
+	state := scorecardModel{
+		Id:                  types.StringValue("sc1"),
+		Name:                types.StringValue("Production Readiness"),
+		Type:                types.StringValue("LEVEL"),
+		EntityFilterType:    types.StringValue("entity_types"),
+		EvaluationFrequency: types.Int64Value(2),
+		EmptyLevelLabel:     types.StringValue("None"),
+		EmptyLevelColor:     types.StringValue("#ffffff"),
+		Description:         types.StringValue("Services"),
+		Levels:              []levelModel{level("l1", "bronze", "Bronze", 1)},
+		Checks: []checkModel{
+			check("c1", "Has owner", "SELECT 1", 0),
+			check("c2", "Has runbook", "SELECT 2", 1),
+			check("c3", "Has alerts", "SELECT 3", 2),
+		},
+	}
+
+	testCases := map[string]struct {
+		plan     func(plan scorecardModel) scorecardModel
+		expected map[string]interface{}
+	}{
+		"no changes": {
+			plan: func(plan scorecardModel) scorecardModel { return plan },
+			expected: map[string]interface{}{
+				"id": "sc1",
+			},
+		},
+		"description only": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Description = types.StringValue("All services")
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id":          "sc1",
+				"description": "All services",
+			},
+		},
+		"cleared description": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Description = types.StringNull()
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id":          "sc1",
+				"description": nil,
+			},
+		},
+		"reformatted sql": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Checks = unknownIds(
+					check("", "Has owner", "select 1;", 0),
+					check("", "Has runbook", "SELECT 2", 1),
+					check("", "Has alerts", "SELECT 3", 2),
+				)
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id": "sc1",
+			},
+		},
+		"one check changed and one removed": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Checks = unknownIds(
+					check("", "Has owner", "SELECT 1", 0),
+					check("", "Has a runbook", "SELECT 2", 1),
+				)
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id": "sc1",
+				"checks": []map[string]interface{}{
+					{"id": "c1"},
+					checkPayload(check("c2", "Has a runbook", "SELECT 2", 1), "LEVEL"),
+				},
+				"levels": []map[string]interface{}{
+					{"id": "l1", "key": "bronze"},
+				},
+			},
+		},
+		"check inserted at the start": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Checks = unknownIds(
+					check("", "Has SLOs", "SELECT 4", 0),
+					check("", "Has owner", "SELECT 1", 1),
+					check("", "Has runbook", "SELECT 2", 2),
+					check("", "Has alerts", "SELECT 3", 3),
+				)
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id": "sc1",
+				"checks": []map[string]interface{}{
+					checkPayload(check("", "Has SLOs", "SELECT 4", 0), "LEVEL"),
+					checkPayload(check("c1", "Has owner", "SELECT 1", 1), "LEVEL"),
+					checkPayload(check("c2", "Has runbook", "SELECT 2", 2), "LEVEL"),
+					checkPayload(check("c3", "Has alerts", "SELECT 3", 3), "LEVEL"),
+				},
+				"levels": []map[string]interface{}{
+					{"id": "l1", "key": "bronze"},
+				},
+			},
+		},
+		"first check removed": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Checks = unknownIds(
+					check("", "Has runbook", "SELECT 2", 0),
+					check("", "Has alerts", "SELECT 3", 1),
+				)
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id": "sc1",
+				"checks": []map[string]interface{}{
+					checkPayload(check("c2", "Has runbook", "SELECT 2", 0), "LEVEL"),
+					checkPayload(check("c3", "Has alerts", "SELECT 3", 1), "LEVEL"),
+				},
+				"levels": []map[string]interface{}{
+					{"id": "l1", "key": "bronze"},
+				},
+			},
+		},
+		"level inserted at the start": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Levels = []levelModel{
+					level("", "starter", "Starter", 0),
+					level("", "bronze", "Bronze", 1),
+				}
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id": "sc1",
+				"levels": []map[string]interface{}{
+					{"key": "starter", "name": "Starter", "color": "#cccccc", "rank": int64(0)},
+					{"id": "l1", "key": "bronze"},
+				},
+			},
+		},
+		"level added": {
+			plan: func(plan scorecardModel) scorecardModel {
+				plan.Levels = []levelModel{
+					level("", "bronze", "Bronze", 1),
+					level("", "silver", "Silver", 2),
+				}
+				return plan
+			},
+			expected: map[string]interface{}{
+				"id": "sc1",
+				"levels": []map[string]interface{}{
+					{"id": "l1", "key": "bronze"},
+					{"key": "silver", "name": "Silver", "color": "#cccccc", "rank": int64(2)},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := scorecardUpdatePayload(context.Background(), state, testCase.plan(state))
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatchElements(t *testing.T) {
+	t.Parallel()
+
+	level := func(key, name string) levelModel {
+		return levelModel{Key: types.StringValue(key), Name: types.StringValue(name)}
+	}
+	prior := []levelModel{level("bronze", "Bronze"), level("silver", "Silver"), level("gold", "Gold")}
+
+	testCases := map[string]struct {
+		planned  []levelModel
+		expected []int
+	}{
+		"unchanged": {
+			planned:  prior,
+			expected: []int{0, 1, 2},
+		},
+		"reordered": {
+			planned:  []levelModel{level("gold", "Gold"), level("bronze", "Bronze"), level("silver", "Silver")},
+			expected: []int{2, 0, 1},
+		},
+		"key kept while another level takes its name": {
+			planned:  []levelModel{level("bronze", "Silver"), level("silver-2", "Bronze")},
+			expected: []int{0, 1},
+		},
+		"renamed key matched by name": {
+			planned:  []levelModel{level("bronze", "Bronze"), level("argent", "Silver")},
+			expected: []int{0, 1},
+		},
+		"renamed level matched by position": {
+			planned:  []levelModel{level("bronze", "Bronze"), level("platinum", "Platinum"), level("gold", "Gold")},
+			expected: []int{0, 1, 2},
+		},
+		"new level at the start": {
+			planned:  []levelModel{level("tin", "Tin"), level("bronze", "Bronze"), level("silver", "Silver"), level("gold", "Gold")},
+			expected: []int{-1, 0, 1, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := matchElements(prior, testCase.planned, levelIdentities...)
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scorecardIdentityModel describes the resource identity data model.
+type scorecardIdentityModel struct {
+	Id      types.String `tfsdk:"id"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+func (r *scorecardResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique ID of the scorecard.",
+			},
+			"base_url": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The DX API base URL the scorecard belongs to. Defaults to the provider's base URL.",
+			},
+		},
+	}
+}
+
+// setIdentity records the identity of the scorecard with the given id. The
+// identity is nil when Terraform does not support resource identities.
+func (r *scorecardResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, scorecardIdentityModel{
+		Id:      types.StringValue(id),
+		BaseURL: types.StringValue(r.client.BaseURL()),
+	})
+}
+
+// importIdFromIdentity returns the scorecard id from an identity supplied in an
+// import block, making sure it belongs to the DX instance the provider targets.
+func (r *scorecardResource) importIdFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) (string, diag.Diagnostics) {
+	var model scorecardIdentityModel
+	diags := identity.Get(ctx, &model)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !model.BaseURL.IsNull() && model.BaseURL.ValueString() != r.client.BaseURL() {
+		diags.AddError(
+			"Mismatched DX base URL",
+			fmt.Sprintf("The identity refers to a scorecard at %s, but the provider is configured for %s.", model.BaseURL.ValueString(), r.client.BaseURL()),
+		)
+		return "", diags
+	}
+
+	return model.Id.ValueString(), diags
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newIdentity returns an empty identity of the scorecard resource, or one
+// holding model if it is not nil.
+func newIdentity(t *testing.T, r *scorecardResource, model *scorecardIdentityModel) *tfsdk.ResourceIdentity {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &schemaResp)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: schemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(schemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+	if model != nil {
+		if diags := identity.Set(ctx, model); diags.HasError() {
+			t.Fatalf("unexpected diagnostics building identity: %v", diags)
+		}
+	}
+	return identity
+}
+
+func TestScorecardIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var resp resource.IdentitySchemaResponse
+	(&scorecardResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &resp)
+
+	if diags := resp.IdentitySchema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	id, ok := resp.IdentitySchema.Attributes["id"]
+	if !ok || !id.IsRequiredForImport() {
+		t.Errorf("expected id to be required for import, got %v", id)
+	}
+	baseURL, ok := resp.IdentitySchema.Attributes["base_url"]
+	if !ok || !baseURL.IsOptionalForImport() {
+		t.Errorf("expected base_url to be optional for import, got %v", baseURL)
+	}
+}
+
+// Create, Read and Update record the identity through setIdentity.
+func TestScorecardSetIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &scorecardResource{client: dxapi.NewClient("https://api.getdx.com", "", "token")}
+
+	if diags := r.setIdentity(ctx, nil, "sc1"); diags.HasError() {
+		t.Errorf("expected no diagnostics without identity support, got %v", diags)
+	}
+
+	identity := newIdentity(t, r, nil)
+	if diags := r.setIdentity(ctx, identity, "sc1"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got scorecardIdentityModel
+	if diags := identity.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading identity: %v", diags)
+	}
+	expected := scorecardIdentityModel{Id: types.StringValue("sc1"), BaseURL: types.StringValue("https://api.getdx.com")}
+	if got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestScorecardImportState(t *testing.T) {
+	t.Parallel()
+
+	r := &scorecardResource{client: dxapi.NewClient("https://api.getdx.com", "", "token")}
+
+	testCases := map[string]struct {
+		id          string
+		identity    *scorecardIdentityModel
+		expectedId  string
+		expectError bool
+	}{
+		"id": {
+			id:         "sc1",
+			expectedId: "sc1",
+		},
+		"identity": {
+			identity:   &scorecardIdentityModel{Id: types.StringValue("sc1"), BaseURL: types.StringNull()},
+			expectedId: "sc1",
+		},
+		"identity with base url": {
+			identity:   &scorecardIdentityModel{Id: types.StringValue("sc1"), BaseURL: types.StringValue("https://api.getdx.com")},
+			expectedId: "sc1",
+		},
+		"identity of another instance": {
+			identity:    &scorecardIdentityModel{Id: types.StringValue("sc1"), BaseURL: types.StringValue("https://api.example.com")},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := resource.ImportStateRequest{ID: testCase.id}
+			if testCase.identity != nil {
+				req.Identity = newIdentity(t, r, testCase.identity)
+			}
+			resp := &resource.ImportStateResponse{
+				State:    scorecardState(t, nil),
+				Identity: newIdentity(t, r, nil),
+			}
+
+			r.ImportState(ctx, req, resp)
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			var id types.String
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			if id.ValueString() != testCase.expectedId {
+				t.Errorf("expected id %q in state, got %s", testCase.expectedId, id)
+			}
+			var identity scorecardIdentityModel
+			if diags := resp.Identity.Get(ctx, &identity); diags.HasError() {
+				t.Fatalf("unexpected diagnostics reading identity: %v", diags)
+			}
+			if identity.Id.ValueString() != testCase.expectedId || identity.BaseURL.ValueString() != "https://api.getdx.com" {
+				t.Errorf("expected identity of %q, got %+v", testCase.expectedId, identity)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importKeysPrivateStateKey is the private state key holding the level and
+// check group keys supplied in an import ID until the first Read.
+const importKeysPrivateStateKey = "import_keys"
+
+// scorecardImportID is a parsed scorecard import identifier. The accepted forms
+// are:
+//
+//	<scorecard id>[;levels=<key>,<key>...][;check_groups=<key>,<key>...]
+//	name:<scorecard name>[;levels=<key>,<key>...][;check_groups=<key>,<key>...]
+//
+// Level and check group keys are matched to the scorecard's levels and check
+// groups by position. When omitted, keys are derived from the names.
+type scorecardImportID struct {
+	Id   string
+	Name string
+	Keys scorecardImportKeys
+}
+
+// scorecardImportKeys are the keys supplied in an import ID.
+type scorecardImportKeys struct {
+	Levels      []string `json:"levels,omitempty"`
+	CheckGroups []string `json:"check_groups,omitempty"`
+}
+
+func parseScorecardImportID(raw string) (scorecardImportID, error) {
+	var parsed scorecardImportID
+
+	parts := strings.Split(raw, ";")
+	selector := strings.TrimSpace(parts[0])
+	if name, ok := strings.CutPrefix(selector, "name:"); ok {
+		parsed.Name = strings.TrimSpace(name)
+		if parsed.Name == "" {
+			return parsed, fmt.Errorf("the scorecard name after 'name:' must not be empty")
+		}
+	} else {
+		parsed.Id = selector
+		if parsed.Id == "" {
+			return parsed, fmt.Errorf("the scorecard id must not be empty")
+		}
+	}
+
+	for _, part := range parts[1:] {
+		option, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return parsed, fmt.Errorf("expected '<option>=<keys>', got %q", part)
+		}
+
+		var keys []string
+		for _, key := range strings.Split(value, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+
+		switch strings.TrimSpace(option) {
+		case "levels":
+			parsed.Keys.Levels = keys
+		case "check_groups":
+			parsed.Keys.CheckGroups = keys
+		default:
+			return parsed, fmt.Errorf("unsupported option %q, expected 'levels' or 'check_groups'", option)
+		}
+	}
+
+	return parsed, nil
+}
+
+func (r *scorecardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import blocks may identify the scorecard by identity instead of an ID.
+	if req.ID == "" {
+		id, diags := r.importIdFromIdentity(ctx, req.Identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, id)...)
+		return
+	}
+
+	importID, err := parseScorecardImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected '<scorecard id>' or 'name:<scorecard name>', optionally followed by ';levels=<keys>' and ';check_groups=<keys>': %s", err.Error()),
+		)
+		return
+	}
+
+	id := importID.Id
+	if importID.Name != "" {
+		id, err = findScorecardIdByName(ctx, r.client, importID.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error importing scorecard", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, id)...)
+
+	if len(importID.Keys.Levels) > 0 || len(importID.Keys.CheckGroups) > 0 {
+		keys, err := json.Marshal(importID.Keys)
+		if err != nil {
+			resp.Diagnostics.AddError("Error importing scorecard", fmt.Sprintf("Could not encode import keys: %s", err.Error()))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importKeysPrivateStateKey, keys)...)
+	}
+}
+
+// findScorecardIdByName returns the id of the only scorecard with exactly the
+// given name.
+func findScorecardIdByName(ctx context.Context, client *dxapi.Client, name string) (string, error) {
+	scorecards, err := client.ListScorecards(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not list scorecards: %w", err)
+	}
+
+	var ids []string
+	for _, scorecard := range scorecards {
+		if scorecard.Name == name {
+			ids = append(ids, scorecard.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no scorecard named %q was found", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d scorecards are named %q (ids: %s), use the id instead", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
+// privateStateGetter is satisfied by the private state of framework requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// getImportKeys returns the keys stored by ImportState, or nil if there are none.
+func getImportKeys(ctx context.Context, private privateStateGetter) (*scorecardImportKeys, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, importKeysPrivateStateKey)
+	if diags.HasError() || len(raw) == 0 {
+		return nil, diags
+	}
+
+	var keys scorecardImportKeys
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		diags.AddError("Error reading import keys", fmt.Sprintf("Could not decode import keys from private state: %s", err.Error()))
+		return nil, diags
+	}
+	return &keys, diags
+}
+
+// seed fills in the keys of a freshly imported model, which has no levels or
+// check groups yet, so they are picked up by position during mapping.
+func (k *scorecardImportKeys) seed(model *scorecardModel) {
+	if len(model.Levels) == 0 {
+		for _, key := range k.Levels {
+			model.Levels = append(model.Levels, levelModel{Key: types.StringValue(key)})
+		}
+	}
+	if len(model.CheckGroups) == 0 {
+		for _, key := range k.CheckGroups {
+			model.CheckGroups = append(model.CheckGroups, checkGroupModel{Key: types.StringValue(key)})
+		}
+	}
+}
+
+// keyOrDerived returns the known key, or derives one from the name when the
+// key is missing because the API does not return it.
+func keyOrDerived(key types.String, name *string) types.String {
+	if !key.IsNull() && !key.IsUnknown() {
+		return key
+	}
+	if name == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(slugify(*name))
+}
+
+// levelKeyFor returns the key of the mapped level a check belongs to.
+func levelKeyFor(level *dxapi.APILevel, levels []levelModel) types.String {
+	if level == nil {
+		return types.StringNull()
+	}
+	if level.Id != nil {
+		for _, l := range levels {
+			if l.Id.ValueString() == *level.Id {
+				return l.Key
+			}
+		}
+	}
+	return keyOrDerived(types.StringNull(), level.Name)
+}
+
+// checkGroupKeyFor returns the key of the mapped check group a check belongs to.
+func checkGroupKeyFor(group *dxapi.APICheckGroup, groups []checkGroupModel) types.String {
+	if group == nil {
+		return types.StringNull()
+	}
+	if group.Id != nil {
+		for _, g := range groups {
+			if g.Id.ValueString() == *group.Id {
+				return g.Key
+			}
+		}
+	}
+	return keyOrDerived(types.StringNull(), group.Name)
+}
+
+// slugify derives a deterministic key from a name, e.g. "Gold Tier!" becomes
+// "gold-tier".
+func slugify(name string) string {
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			pendingDash = false
+			continue
+		}
+		pendingDash = true
+	}
+	return b.String()
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseScorecardImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw       string
+		expected  scorecardImportID
+		expectErr bool
+	}{
+		"id": {
+			raw:      "abc123",
+			expected: scorecardImportID{Id: "abc123"},
+		},
+		"name": {
+			raw:      "name:Production Readiness",
+			expected: scorecardImportID{Name: "Production Readiness"},
+		},
+		"id-with-keys": {
+			raw: "abc123;levels=bronze,silver,gold;check_groups=security",
+			expected: scorecardImportID{
+				Id: "abc123",
+				Keys: scorecardImportKeys{
+					Levels:      []string{"bronze", "silver", "gold"},
+					CheckGroups: []string{"security"},
+				},
+			},
+		},
+		"name-with-keys": {
+			raw: "name:Production Readiness;levels=bronze, silver",
+			expected: scorecardImportID{
+				Name: "Production Readiness",
+				Keys: scorecardImportKeys{Levels: []string{"bronze", "silver"}},
+			},
+		},
+		"empty-id": {
+			raw:       "",
+			expectErr: true,
+		},
+		"empty-name": {
+			raw:       "name:",
+			expectErr: true,
+		},
+		"unknown-option": {
+			raw:       "abc123;checks=a",
+			expectErr: true,
+		},
+		"malformed-option": {
+			raw:       "abc123;levels",
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseScorecardImportID(testCase.raw)
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %+v, got %+v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Gold":                  "gold",
+		"Gold Tier!":            "gold-tier",
+		"  Production  Ready  ": "production-ready",
+		"Tier 2 / Services":     "tier-2-services",
+		"":                      "",
+	}
+
+	for input, expected := range testCases {
+		if got := slugify(input); got != expected {
+			t.Errorf("slugify(%q): expected %q, got %q", input, expected, got)
+		}
+	}
+}
+
+func TestReadRemovesMissingScorecard(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"ok":false,"error":"not_found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+	r := &scorecardResource{client: dxapi.NewClient(server.URL, "", "token")}
+
+	state := scorecardState(t, &scorecardModel{Id: types.StringValue("sc1")})
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected a scorecard missing in DX to be removed from state")
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// destructiveChange is a planned change that affects the results entities see
+// in DX, and that reviewers should therefore look at closely.
+type destructiveChange struct {
+	path    path.Path
+	summary string
+	detail  string
+}
+
+// ModifyPlan plans provider-level defaults, seeds the content of cloned
+// scorecards on create, and points out destructive changes to a scorecard on
+// update. They are reported as warnings, or as errors when the provider
+// requires them to be acknowledged and acknowledge_destructive_changes is not
+// set in this plan.
+func (r *scorecardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to review on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.planDefaultDeletionProtection(ctx, req.Config, &resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(r.seedClonedContent(ctx, &resp.Plan)...)
+		return
+	}
+
+	var state scorecardModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, diags := plannedScorecard(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var acknowledged types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("acknowledge_destructive_changes"), &acknowledged)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fresh := acknowledgedInPlan(state.AcknowledgeDestructiveChanges, acknowledged)
+	stale := acknowledged.ValueBool() && !fresh
+
+	changes := destructiveChanges(ctx, state, plan)
+	for _, change := range changes {
+		if r.requireAckForDestructiveChanges && !fresh {
+			detail := change.detail + " Set acknowledge_destructive_changes = true to apply this change."
+			if stale {
+				detail = change.detail + " acknowledge_destructive_changes is still set from an earlier apply and only acknowledges the plan it is set in: remove it, apply, then set it again to apply this change."
+			}
+			resp.Diagnostics.AddAttributeError(change.path, change.summary, detail)
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(change.path, change.summary, change.detail)
+	}
+
+	if r.requireAckForDestructiveChanges && stale && len(changes) == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("acknowledge_destructive_changes"),
+			"Destructive changes still acknowledged",
+			"acknowledge_destructive_changes only acknowledges the destructive changes of the plan it is set in. Remove it now that they have been applied, so it can acknowledge later changes.",
+		)
+	}
+}
+
+// planDefaultDeletionProtection plans the provider's deletion_protection for
+// a scorecard that does not set it. This cannot be a plan modifier: the
+// framework builds the schema from a resource that was never configured, so
+// the provider setting is only known here. Changing the provider setting
+// therefore plans an update of every scorecard that does not set it.
+func (r *scorecardResource) planDefaultDeletionProtection(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var configured types.Bool
+	diags := config.GetAttribute(ctx, path.Root("deletion_protection"), &configured)
+	if diags.HasError() || !configured.IsNull() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("deletion_protection"), r.defaultDeletionProtection)...)
+	return diags
+}
+
+// acknowledgedInPlan reports whether acknowledge_destructive_changes is set in
+// this plan. An acknowledgement only covers the plan it is set in: one left in
+// the configuration from an earlier apply would otherwise silently acknowledge
+// every later destructive change.
+func acknowledgedInPlan(prior, planned types.Bool) bool {
+	return planned.ValueBool() && !prior.ValueBool()
+}
+
+// plannedScorecard reads the parts of the plan that destructiveChanges looks
+// at. Lists that are not known yet are left nil and marked as unknown, so
+// they are not mistaken for empty lists.
+func plannedScorecard(ctx context.Context, plan tfsdk.Plan) (plannedScorecardModel, diag.Diagnostics) {
+	var planned plannedScorecardModel
+	var diags diag.Diagnostics
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("entity_filter_type"), &planned.EntityFilterType)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("entity_filter_sql"), &planned.EntityFilterSql)...)
+	if diags.HasError() {
+		return planned, diags
+	}
+
+	var identifiers types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("entity_filter_type_identifiers"), &identifiers)...)
+	if diags.HasError() {
+		return planned, diags
+	}
+	planned.identifiersKnown = !identifiers.IsUnknown()
+	if planned.identifiersKnown && !identifiers.IsNull() {
+		diags.Append(identifiers.ElementsAs(ctx, &planned.EntityFilterTypeIdentifiers, false)...)
+	}
+
+	var levels types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("levels"), &levels)...)
+	if diags.HasError() {
+		return planned, diags
+	}
+	planned.levelsKnown = !levels.IsUnknown()
+	if planned.levelsKnown && !levels.IsNull() {
+		if d := levels.ElementsAs(ctx, &planned.Levels, false); d.HasError() {
+			planned.levelsKnown = false
+		}
+	}
+
+	var d diag.Diagnostics
+	planned.Checks, planned.checksKnown, d = plannedChecks(ctx, plan)
+	diags.Append(d...)
+
+	return planned, diags
+}
+
+// plannedScorecardModel holds the planned attributes of a scorecard that
+// destructiveChanges compares against the prior state.
+type plannedScorecardModel struct {
+	EntityFilterType            types.String
+	EntityFilterSql             sqlStringValue
+	EntityFilterTypeIdentifiers []types.String
+	Levels                      []levelModel
+	Checks                      []checkModel
+
+	identifiersKnown bool
+	levelsKnown      bool
+	checksKnown      bool
+}
+
+// destructiveChanges lists the destructive changes between the prior state and
+// the plan. Levels and checks are matched the same way as in update payloads,
+// and each change is reported at the path of the element it affects: the
+// planned element, or the prior one for removed elements.
+func destructiveChanges(ctx context.Context, state scorecardModel, plan plannedScorecardModel) []destructiveChange {
+	var changes []destructiveChange
+	name := state.Name.ValueString()
+
+	// Changing the entity filter changes which entities are scored, whether or
+	// not the scorecard is published.
+	filterChanged := !semanticallyEqual(ctx, state.EntityFilterType, plan.EntityFilterType) ||
+		!semanticallyEqual(ctx, state.EntityFilterSql, plan.EntityFilterSql) ||
+		(plan.identifiersKnown && !stringsEqual(state.EntityFilterTypeIdentifiers, plan.EntityFilterTypeIdentifiers))
+	if filterChanged {
+		changes = append(changes, destructiveChange{
+			path:    path.Root("entity_filter_type"),
+			summary: "Entity filter change",
+			detail:  fmt.Sprintf("The entity filter of scorecard %q changes. Entities may start or stop being scored, and the results of entities that are no longer scored are lost.", name),
+		})
+	}
+
+	if !state.Published.ValueBool() {
+		return changes
+	}
+
+	if plan.levelsKnown {
+		matches := matchElements(state.Levels, plan.Levels, levelIdentities...)
+		removed, indices := unmatchedElements(state.Levels, matches)
+		for k, level := range removed {
+			changes = append(changes, destructiveChange{
+				path:    path.Root("levels").AtListIndex(indices[k]),
+				summary: "Level removed from published scorecard",
+				detail:  fmt.Sprintf("Level %q is removed from the published scorecard %q. Entities at this level will drop to a lower level.", level.Name.ValueString(), name),
+			})
+		}
+	}
+
+	if plan.checksKnown {
+		matches := matchElements(state.Checks, plan.Checks, checkIdentities...)
+		for i, check := range plan.Checks {
+			if matches[i] < 0 {
+				continue
+			}
+			prior := state.Checks[matches[i]]
+			if prior.Published.ValueBool() && !semanticallyEqual(ctx, prior.Sql, check.Sql) {
+				changes = append(changes, destructiveChange{
+					path:    path.Root("checks").AtListIndex(i).AtName("sql"),
+					summary: "SQL of published check changed",
+					detail:  fmt.Sprintf("The SQL of the published check %q changes. Entities may start or stop passing it as soon as the scorecard is next evaluated.", prior.Name.ValueString()),
+				})
+			}
+		}
+		removed, indices := unmatchedElements(state.Checks, matches)
+		for k, check := range removed {
+			changes = append(changes, destructiveChange{
+				path:    path.Root("checks").AtListIndex(indices[k]),
+				summary: "Check removed from published scorecard",
+				detail:  fmt.Sprintf("Check %q is removed from the published scorecard %q, together with its results and history.", check.Name.ValueString(), name),
+			})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDestructiveChanges(t *testing.T) {
+	t.Parallel()
+
+	check := func(name, sql string, published bool) checkModel {
+		return checkModel{
+			Name:      types.StringValue(name),
+			Sql:       newSQLStringValue(sql),
+			Published: types.BoolValue(published),
+		}
+	}
+	level := func(name string) levelModel {
+		return levelModel{Name: types.StringValue(name)}
+	}
+
+	state := func(published bool) scorecardModel {
+		return scorecardModel{
+			Name:             types.StringValue("Production Readiness"),
+			Published:        types.BoolValue(published),
+			EntityFilterType: types.StringValue("entity_types"),
+			EntityFilterSql:  newSQLStringNull(),
+			Levels:           []levelModel{level("Bronze"), level("Silver")},
+			Checks: []checkModel{
+				check("Has owner", "SELECT 1", true),
+				check("Has runbook", "SELECT 2", false),
+				check("Has alerts", "SELECT 3", true),
+			},
+		}
+	}
+	unchanged := func(state scorecardModel) plannedScorecardModel {
+		return plannedScorecardModel{
+			EntityFilterType: state.EntityFilterType,
+			EntityFilterSql:  state.EntityFilterSql,
+			Levels:           state.Levels,
+			Checks:           state.Checks,
+			identifiersKnown: true,
+			levelsKnown:      true,
+			checksKnown:      true,
+		}
+	}
+
+	testCases := map[string]struct {
+		state         scorecardModel
+		plan          func(plan plannedScorecardModel) plannedScorecardModel
+		expectedPaths []path.Path
+	}{
+		"no changes": {
+			state: state(true),
+			plan:  func(plan plannedScorecardModel) plannedScorecardModel { return plan },
+		},
+		"entity filter changed": {
+			state: state(false),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.EntityFilterType = types.StringValue("sql")
+				plan.EntityFilterSql = newSQLStringValue("SELECT id FROM entities")
+				return plan
+			},
+			expectedPaths: []path.Path{path.Root("entity_filter_type")},
+		},
+		"reformatted sql of published check": {
+			state: state(true),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.Checks = []checkModel{
+					check("Has owner", "select 1;", true),
+					check("Has runbook", "SELECT 2", false),
+					check("Has alerts", "SELECT 3", true),
+				}
+				return plan
+			},
+		},
+		"sql changed and check and level removed": {
+			state: state(true),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.Levels = []levelModel{level("Bronze")}
+				plan.Checks = []checkModel{
+					check("Has owner", "SELECT 10", true),
+					check("Has runbook", "SELECT 20", false),
+				}
+				return plan
+			},
+			expectedPaths: []path.Path{
+				path.Root("levels").AtListIndex(1),
+				path.Root("checks").AtListIndex(0).AtName("sql"),
+				path.Root("checks").AtListIndex(2),
+			},
+		},
+		"first check and level removed": {
+			state: state(true),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.Levels = []levelModel{level("Silver")}
+				plan.Checks = []checkModel{
+					check("Has runbook", "SELECT 2", false),
+					check("Has alerts", "SELECT 3", true),
+				}
+				return plan
+			},
+			expectedPaths: []path.Path{
+				path.Root("levels").AtListIndex(0),
+				path.Root("checks").AtListIndex(0),
+			},
+		},
+		"check inserted at the start": {
+			state: state(true),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.Checks = append([]checkModel{check("Has SLOs", "SELECT 4", true)}, plan.Checks...)
+				return plan
+			},
+		},
+		"unpublished scorecard": {
+			state: state(false),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.Levels = nil
+				plan.Checks = []checkModel{check("Has owner", "SELECT 10", true)}
+				return plan
+			},
+		},
+		"unknown checks": {
+			state: state(true),
+			plan: func(plan plannedScorecardModel) plannedScorecardModel {
+				plan.Checks = nil
+				plan.checksKnown = false
+				return plan
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := destructiveChanges(context.Background(), testCase.state, testCase.plan(unchanged(testCase.state)))
+
+			var got []path.Path
+			for _, change := range changes {
+				got = append(got, change.path)
+			}
+			if !reflect.DeepEqual(got, testCase.expectedPaths) {
+				t.Errorf("expected changes at %v, got %v", testCase.expectedPaths, got)
+			}
+		})
+	}
+}
+
+func TestAcknowledgedInPlan(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior, planned types.Bool
+		expected       bool
+	}{
+		"not set":           {prior: types.BoolNull(), planned: types.BoolNull(), expected: false},
+		"set in this plan":  {prior: types.BoolNull(), planned: types.BoolValue(true), expected: true},
+		"set after false":   {prior: types.BoolValue(false), planned: types.BoolValue(true), expected: true},
+		"left from earlier": {prior: types.BoolValue(true), planned: types.BoolValue(true), expected: false},
+		"removed":           {prior: types.BoolValue(true), planned: types.BoolNull(), expected: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := acknowledgedInPlan(testCase.prior, testCase.planned); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestModifyPlanDefaultDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	// The resource is configured the way the framework configures it, rather
+	// than built with the default set.
+	r := &scorecardResource{}
+	var configureResp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &scorecardProviderData{
+			client:             dxapi.NewClient("https://api.getdx.com", "", "token"),
+			deletionProtection: true,
+		},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	scorecard := func(protection types.Bool) *scorecardModel {
+		return &scorecardModel{
+			Id:                 types.StringValue("sc1"),
+			Name:               types.StringValue("Production Readiness"),
+			Type:               types.StringValue("LEVEL"),
+			DeletionProtection: protection,
+		}
+	}
+
+	testCases := map[string]struct {
+		state    *scorecardModel
+		config   types.Bool
+		expected types.Bool
+	}{
+		"create": {
+			config:   types.BoolNull(),
+			expected: types.BoolValue(true),
+		},
+		"unset before the provider default changed": {
+			state:    scorecard(types.BoolValue(false)),
+			config:   types.BoolNull(),
+			expected: types.BoolValue(true),
+		},
+		"unset": {
+			state:    scorecard(types.BoolValue(true)),
+			config:   types.BoolNull(),
+			expected: types.BoolValue(true),
+		},
+		"configured": {
+			state:    scorecard(types.BoolValue(false)),
+			config:   types.BoolValue(false),
+			expected: types.BoolValue(false),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			config := scorecardState(t, scorecard(testCase.config))
+			// The framework plans unset computed attributes as unknown.
+			planned := scorecard(testCase.config)
+			if testCase.config.IsNull() {
+				planned.DeletionProtection = types.BoolUnknown()
+			}
+			plan := scorecardState(t, planned)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State:  scorecardState(t, testCase.state),
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			var got types.Bool
+			resp.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &got)
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected deletion_protection %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Options for publish_strategy.
+const (
+	publishImmediate            = "immediate"
+	publishAfterFirstEvaluation = "after_first_evaluation"
+)
+
+// Defaults for how long and how often to poll DX for a scorecard evaluation,
+// used by publish_strategy and wait_for_evaluation.
+const (
+	defaultEvaluationTimeout      = "30m"
+	defaultEvaluationPollInterval = "30s"
+)
+
+// pendingPublishPrivateStateKey is the private state key marking a scorecard
+// that was created to be published, but could not be published yet. State
+// keeps the planned published value, so the scorecard is not tainted.
+const pendingPublishPrivateStateKey = "pending_publish"
+
+// setPendingPublish records in private state whether the scorecard still has
+// to be published.
+func setPendingPublish(ctx context.Context, private privateStateSetter, pending bool) diag.Diagnostics {
+	if !pending {
+		return private.SetKey(ctx, pendingPublishPrivateStateKey, nil)
+	}
+	return private.SetKey(ctx, pendingPublishPrivateStateKey, []byte("true"))
+}
+
+// getPendingPublish reports whether the scorecard still has to be published;
+// see setPendingPublish.
+func getPendingPublish(ctx context.Context, private privateStateGetter) (bool, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, pendingPublishPrivateStateKey)
+	return len(raw) > 0, diags
+}
+
+// keepPlannedPublished keeps the planned published value in a model mapped
+// from a new scorecard that could not be published after its evaluation, and
+// reports whether that publish is still pending. State must hold the planned
+// value, or Terraform reports an inconsistent result and taints the
+// scorecard; Read reports it as unpublished until the next apply publishes it.
+func keepPlannedPublished(model *scorecardModel, planned types.Bool, publishAfterEvaluation bool, written dxapi.APIScorecard) bool {
+	if !publishAfterEvaluation || written.Published {
+		return false
+	}
+	model.Published = planned
+	return true
+}
+
+// publishesAfterEvaluation reports whether the plan publishes the scorecard
+// only once DX has evaluated it.
+func publishesAfterEvaluation(plan scorecardModel) bool {
+	return plan.Published.ValueBool() && plan.PublishStrategy.ValueString() == publishAfterFirstEvaluation
+}
+
+// changesContent reports whether a payload changes anything besides the
+// published flag.
+func changesContent(payload map[string]interface{}) bool {
+	for key := range payload {
+		if key != "id" && key != "published" {
+			return true
+		}
+	}
+	return false
+}
+
+// publishAfterEvaluation waits until DX has evaluated a scorecard that was
+// written unpublished, and then publishes it. When waiting or publishing
+// fails, the scorecard is left unpublished and the written response is
+// returned along with a diagnostic; see addWrittenDiagnostic.
+func (r *scorecardResource) publishAfterEvaluation(ctx context.Context, plan scorecardModel, written *dxapi.APIResponse, created bool) (*dxapi.APIResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id := written.Scorecard.Id
+
+	// Both durations are validated by the schema.
+	timeout, _ := time.ParseDuration(plan.PublishTimeout.ValueString())
+	interval, _ := time.ParseDuration(plan.PublishPollInterval.ValueString())
+
+	if _, err := r.waitForEvaluation(ctx, id, evaluationReference(written.Scorecard), timeout, interval); err != nil {
+		addWrittenDiagnostic(&diags, created, "Scorecard left unpublished",
+			fmt.Sprintf("Scorecard %q (ID %s) was %s unpublished, but could not be published because %s. The next apply tries to publish it again.",
+				written.Scorecard.Name, id, writtenVerb(created), err))
+		return written, diags
+	}
+
+	published, err := r.client.UpdateScorecard(ctx, map[string]interface{}{"id": id, "published": true})
+	if err != nil {
+		addWrittenDiagnostic(&diags, created, "Error publishing scorecard",
+			fmt.Sprintf("Scorecard %q (ID %s) was %s unpublished, but publishing it failed: %s. The next apply tries to publish it again.",
+				written.Scorecard.Name, id, writtenVerb(created), err))
+		return written, diags
+	}
+	return published, diags
+}
+
+// awaitEvaluation waits until DX has evaluated a written scorecard, as
+// configured by wait_for_evaluation. It returns the scorecard as of that
+// evaluation, or the written response along with a diagnostic if waiting
+// failed; see addWrittenDiagnostic.
+func (r *scorecardResource) awaitEvaluation(ctx context.Context, wait *waitForEvaluationModel, written *dxapi.APIResponse, created bool) (*dxapi.APIResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Both durations are validated by the schema.
+	timeout, _ := time.ParseDuration(wait.Timeout.ValueString())
+	interval, _ := time.ParseDuration(wait.PollInterval.ValueString())
+
+	evaluated, err := r.waitForEvaluation(ctx, written.Scorecard.Id, evaluationReference(written.Scorecard), timeout, interval)
+	if err != nil {
+		addWrittenDiagnostic(&diags, created, "Scorecard not evaluated",
+			fmt.Sprintf("Scorecard %q (ID %s) was %s, but %s.", written.Scorecard.Name, written.Scorecard.Id, writtenVerb(created), err))
+		return written, diags
+	}
+	return evaluated, diags
+}
+
+// addWrittenDiagnostic reports a failure that happened after the scorecard was
+// written. On create it is only a warning: an error would taint the new
+// scorecard, and the next apply would replace it, losing its history, rather
+// than retry what failed.
+func addWrittenDiagnostic(diags *diag.Diagnostics, created bool, summary, detail string) {
+	if created {
+		diags.AddWarning(summary, detail)
+		return
+	}
+	diags.AddError(summary, detail)
+}
+
+// writtenVerb describes how a scorecard was written.
+func writtenVerb(created bool) string {
+	if created {
+		return "created"
+	}
+	return "saved"
+}
+
+// waitForEvaluation polls DX until the scorecard has been evaluated after the
+// given time, and returns the scorecard as of that evaluation.
+func (r *scorecardResource) waitForEvaluation(ctx context.Context, id string, since time.Time, timeout, interval time.Duration) (*dxapi.APIResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		apiResp, err := r.client.GetScorecard(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("it was not evaluated within %s", timeout)
+			}
+			return nil, fmt.Errorf("its evaluation status could not be read: %w", err)
+		}
+		if evaluatedSince(apiResp.Scorecard, since) {
+			return apiResp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("it was not evaluated within %s", timeout)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// evaluationReference returns the time an evaluation has to be newer than to
+// reflect a write. It is the time DX recorded for the write, or the current
+// time if DX did not return one.
+func evaluationReference(scorecard dxapi.APIScorecard) time.Time {
+	if scorecard.UpdatedAt != nil {
+		if updatedAt, err := time.Parse(time.RFC3339, *scorecard.UpdatedAt); err == nil {
+			return updatedAt
+		}
+	}
+	return time.Now()
+}
+
+// evaluatedSince reports whether DX evaluated the scorecard after the given time.
+func evaluatedSince(scorecard dxapi.APIScorecard, since time.Time) bool {
+	if scorecard.LastEvaluatedAt == nil {
+		return false
+	}
+	evaluatedAt, err := time.Parse(time.RFC3339, *scorecard.LastEvaluatedAt)
+	if err != nil {
+		return false
+	}
+	return evaluatedAt.After(since)
+}
+
+// durationValidator validates that a string is a positive Go duration, such as
+// "30s" or "10m".
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration, such as \"30s\" or \"10m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
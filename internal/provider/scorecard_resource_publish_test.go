@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEvaluatedSince(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	timestamp := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		lastEvaluatedAt *string
+		expected        bool
+	}{
+		"never evaluated":    {nil, false},
+		"evaluated before":   {timestamp("2025-03-01T11:59:59Z"), false},
+		"evaluated at write": {timestamp("2025-03-01T12:00:00Z"), false},
+		"evaluated after":    {timestamp("2025-03-01T12:00:01Z"), true},
+		"other time zone":    {timestamp("2025-03-01T13:30:00+01:00"), true},
+		"invalid timestamp":  {timestamp("yesterday"), false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := evaluatedSince(dxapi.APIScorecard{LastEvaluatedAt: testCase.lastEvaluatedAt}, since)
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null":     {types.StringNull(), false},
+		"unknown":  {types.StringUnknown(), false},
+		"seconds":  {types.StringValue("30s"), false},
+		"combined": {types.StringValue("1h30m"), false},
+		"no unit":  {types.StringValue("30"), true},
+		"zero":     {types.StringValue("0s"), true},
+		"negative": {types.StringValue("-5m"), true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{Path: path.Root("publish_timeout"), ConfigValue: testCase.value}
+			var resp validator.StringResponse
+			durationValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got %s", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAddWrittenDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	var created diag.Diagnostics
+	addWrittenDiagnostic(&created, true, "Scorecard not evaluated", "Scorecard was created, but timed out.")
+	if created.HasError() || created.WarningsCount() != 1 {
+		t.Errorf("expected a warning on create, so the scorecard is not tainted, got %v", created)
+	}
+
+	var updated diag.Diagnostics
+	addWrittenDiagnostic(&updated, false, "Scorecard not evaluated", "Scorecard was saved, but timed out.")
+	if !updated.HasError() {
+		t.Errorf("expected an error on update, got %v", updated)
+	}
+}
+
+// fakePrivateState stands in for the private state of framework requests and
+// responses.
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func TestKeepPlannedPublished(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		publishAfterEvaluation bool
+		written                dxapi.APIScorecard
+		expectPending          bool
+		expected               types.Bool
+	}{
+		"published immediately": {
+			written:  dxapi.APIScorecard{Published: true},
+			expected: types.BoolValue(true),
+		},
+		"published after evaluation": {
+			publishAfterEvaluation: true,
+			written:                dxapi.APIScorecard{Published: true},
+			expected:               types.BoolValue(true),
+		},
+		"left unpublished": {
+			publishAfterEvaluation: true,
+			written:                dxapi.APIScorecard{Published: false},
+			expectPending:          true,
+			expected:               types.BoolValue(true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The model as mapped from the written scorecard.
+			model := scorecardModel{Published: types.BoolValue(testCase.written.Published)}
+			pending := keepPlannedPublished(&model, types.BoolValue(true), testCase.publishAfterEvaluation, testCase.written)
+			if pending != testCase.expectPending {
+				t.Errorf("expected pending %t, got %t", testCase.expectPending, pending)
+			}
+			if !model.Published.Equal(testCase.expected) {
+				t.Errorf("expected published %s in state, got %s", testCase.expected, model.Published)
+			}
+		})
+	}
+}
+
+func TestPendingPublish(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	private := fakePrivateState{}
+
+	if pending, _ := getPendingPublish(ctx, private); pending {
+		t.Error("expected no pending publish before one is recorded")
+	}
+	setPendingPublish(ctx, private, true)
+	if pending, _ := getPendingPublish(ctx, private); !pending {
+		t.Error("expected the pending publish to be recorded")
+	}
+	setPendingPublish(ctx, private, false)
+	if pending, _ := getPendingPublish(ctx, private); pending {
+		t.Error("expected the pending publish to be cleared")
+	}
+}
+
+// fakeEvaluationServer serves a scorecard that DX evaluates after a number of
+// reads, and publishes it when it is updated.
+type fakeEvaluationServer struct {
+	mu             sync.Mutex
+	evaluatedAfter int // reads before the scorecard is evaluated, or -1 for never
+	status         int
+	reads          int
+	published      bool
+}
+
+func (s *fakeEvaluationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status != 0 {
+		http.Error(w, `{"ok":false}`, s.status)
+		return
+	}
+
+	scorecard := dxapi.APIScorecard{Id: "sc1", Name: "Production Readiness"}
+	if r.Method == http.MethodGet {
+		s.reads++
+	} else {
+		s.published = true
+	}
+	if s.evaluatedAfter >= 0 && s.reads > s.evaluatedAfter {
+		evaluatedAt := "2025-03-01T12:05:00Z"
+		scorecard.LastEvaluatedAt = &evaluatedAt
+	}
+	scorecard.Published = s.published
+	body, _ := json.Marshal(dxapi.APIResponse{Ok: true, Scorecard: scorecard})
+	_, _ = w.Write(body)
+}
+
+func TestAwaitEvaluation(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := "2025-03-01T12:00:00Z"
+	written := &dxapi.APIResponse{Scorecard: dxapi.APIScorecard{Id: "sc1", Name: "Production Readiness", UpdatedAt: &updatedAt}}
+	wait := &waitForEvaluationModel{Timeout: types.StringValue("200ms"), PollInterval: types.StringValue("5ms")}
+
+	testCases := map[string]struct {
+		evaluatedAfter int
+		status         int
+		created        bool
+		expectWarning  string
+		expectError    string
+		expectReads    int
+	}{
+		"evaluated": {
+			evaluatedAfter: 2,
+			expectReads:    3,
+		},
+		"timed out on create": {
+			evaluatedAfter: -1,
+			created:        true,
+			expectWarning:  "was created, but it was not evaluated within 200ms",
+		},
+		"timed out on update": {
+			evaluatedAfter: -1,
+			expectError:    "was saved, but it was not evaluated within 200ms",
+		},
+		"unreadable": {
+			status:      http.StatusInternalServerError,
+			expectError: "its evaluation status could not be read",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeEvaluationServer{evaluatedAfter: testCase.evaluatedAfter, status: testCase.status}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+			r := &scorecardResource{client: dxapi.NewClient(server.URL, "", "token")}
+
+			got, diags := r.awaitEvaluation(context.Background(), wait, written, testCase.created)
+
+			switch {
+			case testCase.expectWarning != "":
+				if diags.HasError() || len(diags.Warnings()) != 1 || !strings.Contains(diags.Warnings()[0].Detail(), testCase.expectWarning) {
+					t.Errorf("expected a warning containing %q, got %v", testCase.expectWarning, diags)
+				}
+			case testCase.expectError != "":
+				if len(diags.Errors()) != 1 || !strings.Contains(diags.Errors()[0].Detail(), testCase.expectError) {
+					t.Errorf("expected an error containing %q, got %v", testCase.expectError, diags)
+				}
+			default:
+				if len(diags) != 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				if got.Scorecard.LastEvaluatedAt == nil {
+					t.Error("expected the evaluated scorecard to be returned")
+				}
+				fake.mu.Lock()
+				defer fake.mu.Unlock()
+				if fake.reads != testCase.expectReads {
+					t.Errorf("expected %d reads, got %d", testCase.expectReads, fake.reads)
+				}
+				return
+			}
+			if got != written {
+				t.Error("expected the written scorecard to be returned when waiting fails")
+			}
+		})
+	}
+}
+
+func TestPublishAfterEvaluation(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := "2025-03-01T12:00:00Z"
+	written := &dxapi.APIResponse{Scorecard: dxapi.APIScorecard{Id: "sc1", Name: "Production Readiness", UpdatedAt: &updatedAt}}
+	plan := scorecardModel{PublishTimeout: types.StringValue("200ms"), PublishPollInterval: types.StringValue("5ms")}
+
+	t.Run("published", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(&fakeEvaluationServer{evaluatedAfter: 1})
+		defer server.Close()
+		r := &scorecardResource{client: dxapi.NewClient(server.URL, "", "token")}
+
+		got, diags := r.publishAfterEvaluation(context.Background(), plan, written, true)
+		if len(diags) != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !got.Scorecard.Published {
+			t.Error("expected the scorecard to be published once evaluated")
+		}
+	})
+
+	t.Run("left unpublished on create", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeEvaluationServer{evaluatedAfter: -1}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+		r := &scorecardResource{client: dxapi.NewClient(server.URL, "", "token")}
+
+		got, diags := r.publishAfterEvaluation(context.Background(), plan, written, true)
+		if diags.HasError() || len(diags.Warnings()) != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "was created unpublished") {
+			t.Errorf("expected a warning that the scorecard was created unpublished, got %v", diags)
+		}
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		if got != written || fake.published {
+			t.Error("expected the scorecard not to be published before it is evaluated")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeleteProtection(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		onDestroy   string
+		expectError bool
+	}{
+		"delete": {
+			onDestroy:   onDestroyDelete,
+			expectError: true,
+		},
+		"unpublish": {
+			onDestroy:   onDestroyUnpublish,
+			expectError: true,
+		},
+		// Abandoning leaves the scorecard in DX, so protection does not apply.
+		"abandon": {
+			onDestroy: onDestroyAbandon,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			}))
+			defer server.Close()
+			r := &scorecardResource{client: dxapi.NewClient(server.URL, "", "token")}
+
+			state := scorecardState(t, &scorecardModel{
+				Id:                 types.StringValue("sc1"),
+				Name:               types.StringValue("Production Readiness"),
+				DeletionProtection: types.BoolValue(true),
+				OnDestroy:          types.StringValue(testCase.onDestroy),
+			})
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if called {
+				t.Error("expected a protected scorecard to be left untouched in DX")
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scorecardModelV0 describes the version 0 resource data model, in which all
+// numeric attributes were stored as arbitrary precision numbers.
+type scorecardModelV0 struct {
+	Id                          types.String        `tfsdk:"id"`
+	Name                        types.String        `tfsdk:"name"`
+	Type                        types.String        `tfsdk:"type"`
+	EntityFilterType            types.String        `tfsdk:"entity_filter_type"`
+	EvaluationFrequency         types.Number        `tfsdk:"evaluation_frequency_hours"`
+	EmptyLevelLabel             types.String        `tfsdk:"empty_level_label"`
+	EmptyLevelColor             types.String        `tfsdk:"empty_level_color"`
+	Levels                      []levelModelV0      `tfsdk:"levels"`
+	CheckGroups                 []checkGroupModelV0 `tfsdk:"check_groups"`
+	Description                 types.String        `tfsdk:"description"`
+	Published                   types.Bool          `tfsdk:"published"`
+	EntityFilterTypeIdentifiers []types.String      `tfsdk:"entity_filter_type_identifiers"`
+	EntityFilterSql             types.String        `tfsdk:"entity_filter_sql"`
+	Checks                      []checkModelV0      `tfsdk:"checks"`
+}
+
+type levelModelV0 struct {
+	Key   types.String `tfsdk:"key"`
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Color types.String `tfsdk:"color"`
+	Rank  types.Number `tfsdk:"rank"`
+}
+
+type checkGroupModelV0 struct {
+	Key      types.String `tfsdk:"key"`
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Ordering types.Number `tfsdk:"ordering"`
+}
+
+type checkModelV0 struct {
+	Id                     types.String       `tfsdk:"id"`
+	Name                   types.String       `tfsdk:"name"`
+	Description            types.String       `tfsdk:"description"`
+	Ordering               types.Number       `tfsdk:"ordering"`
+	Sql                    types.String       `tfsdk:"sql"`
+	FilterSql              types.String       `tfsdk:"filter_sql"`
+	FilterMessage          types.String       `tfsdk:"filter_message"`
+	OutputEnabled          types.Bool         `tfsdk:"output_enabled"`
+	OutputType             types.String       `tfsdk:"output_type"`
+	OutputAggregation      types.String       `tfsdk:"output_aggregation"`
+	OutputCustomOptions    types.String       `tfsdk:"output_custom_options"`
+	EstimatedDevDays       types.Number       `tfsdk:"estimated_dev_days"`
+	ExternalUrl            types.String       `tfsdk:"external_url"`
+	Published              types.Bool         `tfsdk:"published"`
+	ScorecardLevelKey      types.String       `tfsdk:"scorecard_level_key"`
+	Level                  *levelModelV0      `tfsdk:"level"`
+	ScorecardCheckGroupKey types.String       `tfsdk:"scorecard_check_group_key"`
+	CheckGroup             *checkGroupModelV0 `tfsdk:"check_group"`
+	Points                 types.Number       `tfsdk:"points"`
+}
+
+// scorecardSchemaV0 returns the version 0 schema. Only the attribute types
+// matter for decoding prior state, so descriptions and plan modifiers are omitted.
+func scorecardSchemaV0() *schema.Schema {
+	levelAttributes := map[string]schema.Attribute{
+		"key":   schema.StringAttribute{Required: true},
+		"id":    schema.StringAttribute{Computed: true},
+		"name":  schema.StringAttribute{Required: true},
+		"color": schema.StringAttribute{Required: true},
+		"rank":  schema.NumberAttribute{Required: true},
+	}
+	checkGroupAttributes := map[string]schema.Attribute{
+		"key":      schema.StringAttribute{Required: true},
+		"id":       schema.StringAttribute{Computed: true},
+		"name":     schema.StringAttribute{Required: true},
+		"ordering": schema.NumberAttribute{Required: true},
+	}
+
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                         schema.StringAttribute{Computed: true},
+			"name":                       schema.StringAttribute{Required: true},
+			"type":                       schema.StringAttribute{Required: true},
+			"entity_filter_type":         schema.StringAttribute{Required: true},
+			"evaluation_frequency_hours": schema.NumberAttribute{Required: true},
+			"empty_level_label":          schema.StringAttribute{Optional: true},
+			"empty_level_color":          schema.StringAttribute{Optional: true},
+			"levels": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: levelAttributes},
+			},
+			"check_groups": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: checkGroupAttributes},
+			},
+			"description": schema.StringAttribute{Optional: true},
+			"published":   schema.BoolAttribute{Optional: true},
+			"entity_filter_type_identifiers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"entity_filter_sql": schema.StringAttribute{Optional: true},
+			"checks": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                        schema.StringAttribute{Computed: true},
+						"name":                      schema.StringAttribute{Required: true},
+						"description":               schema.StringAttribute{Required: true},
+						"ordering":                  schema.NumberAttribute{Required: true},
+						"sql":                       schema.StringAttribute{Required: true},
+						"filter_sql":                schema.StringAttribute{Required: true},
+						"filter_message":            schema.StringAttribute{Required: true},
+						"output_enabled":            schema.BoolAttribute{Required: true},
+						"output_type":               schema.StringAttribute{Required: true},
+						"output_aggregation":        schema.StringAttribute{Required: true},
+						"output_custom_options":     schema.StringAttribute{Required: true},
+						"estimated_dev_days":        schema.NumberAttribute{Required: true},
+						"external_url":              schema.StringAttribute{Required: true},
+						"published":                 schema.BoolAttribute{Required: true},
+						"scorecard_level_key":       schema.StringAttribute{Optional: true},
+						"level":                     schema.SingleNestedAttribute{Optional: true, Attributes: levelAttributes},
+						"scorecard_check_group_key": schema.StringAttribute{Optional: true},
+						"check_group":               schema.SingleNestedAttribute{Optional: true, Attributes: checkGroupAttributes},
+						"points":                    schema.NumberAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *scorecardResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored numeric attributes as types.Number. Version 1 uses
+		// types.Int64, so the values are converted in place.
+		0: {
+			PriorSchema:   scorecardSchemaV0(),
+			StateUpgrader: upgradeScorecardStateV0toV1,
+		},
+	}
+}
+
+func upgradeScorecardStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior scorecardModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := scorecardModel{
+		Id:                          prior.Id,
+		Name:                        prior.Name,
+		Type:                        prior.Type,
+		EntityFilterType:            prior.EntityFilterType,
+		EvaluationFrequency:         numberToInt64(prior.EvaluationFrequency),
+		EmptyLevelLabel:             prior.EmptyLevelLabel,
+		EmptyLevelColor:             prior.EmptyLevelColor,
+		Description:                 prior.Description,
+		Published:                   prior.Published,
+		EntityFilterTypeIdentifiers: prior.EntityFilterTypeIdentifiers,
+		EntityFilterSql:             sqlStringValue{StringValue: prior.EntityFilterSql},
+	}
+
+	if prior.Levels != nil {
+		upgraded.Levels = make([]levelModel, len(prior.Levels))
+		for i, level := range prior.Levels {
+			upgraded.Levels[i] = level.upgrade()
+		}
+	}
+
+	if prior.CheckGroups != nil {
+		upgraded.CheckGroups = make([]checkGroupModel, len(prior.CheckGroups))
+		for i, group := range prior.CheckGroups {
+			upgraded.CheckGroups[i] = group.upgrade()
+		}
+	}
+
+	if prior.Checks != nil {
+		upgraded.Checks = make([]checkModel, len(prior.Checks))
+		for i, check := range prior.Checks {
+			upgraded.Checks[i] = checkModel{
+				Id:                     check.Id,
+				Name:                   check.Name,
+				Description:            check.Description,
+				Ordering:               numberToInt64(check.Ordering),
+				Sql:                    sqlStringValue{StringValue: check.Sql},
+				FilterSql:              sqlStringValue{StringValue: check.FilterSql},
+				FilterMessage:          check.FilterMessage,
+				OutputEnabled:          check.OutputEnabled,
+				OutputType:             check.OutputType,
+				OutputAggregation:      check.OutputAggregation,
+				OutputCustomOptions:    jsonStringValue{StringValue: check.OutputCustomOptions},
+				EstimatedDevDays:       numberToInt64(check.EstimatedDevDays),
+				ExternalUrl:            check.ExternalUrl,
+				Published:              check.Published,
+				ScorecardLevelKey:      check.ScorecardLevelKey,
+				ScorecardCheckGroupKey: check.ScorecardCheckGroupKey,
+				Points:                 numberToInt64(check.Points),
+			}
+			if check.Level != nil {
+				level := check.Level.upgrade()
+				upgraded.Checks[i].Level = &level
+			}
+			if check.CheckGroup != nil {
+				group := check.CheckGroup.upgrade()
+				upgraded.Checks[i].CheckGroup = &group
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
+
+func (l levelModelV0) upgrade() levelModel {
+	return levelModel{
+		Key:   l.Key,
+		Id:    l.Id,
+		Name:  l.Name,
+		Color: l.Color,
+		Rank:  numberToInt64(l.Rank),
+	}
+}
+
+func (g checkGroupModelV0) upgrade() checkGroupModel {
+	return checkGroupModel{
+		Key:      g.Key,
+		Id:       g.Id,
+		Name:     g.Name,
+		Ordering: numberToInt64(g.Ordering),
+	}
+}
+
+// numberToInt64 converts a types.Number to a types.Int64, truncating any
+// fractional part. Null and unknown values are preserved.
+func numberToInt64(n types.Number) types.Int64 {
+	if n.IsNull() {
+		return types.Int64Null()
+	}
+	if n.IsUnknown() {
+		return types.Int64Unknown()
+	}
+	i, _ := n.ValueBigFloat().Int64()
+	return types.Int64Value(i)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// scorecardStateV0 is version 0 state as Terraform stored it, with numbers
+// that are null or fractional.
+const scorecardStateV0 = `{
+	"id": "sc1",
+	"name": "Production Readiness",
+	"type": "LEVEL",
+	"entity_filter_type": "entity_types",
+	"evaluation_frequency_hours": 24,
+	"empty_level_label": "None",
+	"empty_level_color": "#ffffff",
+	"levels": [
+		{"key": "bronze", "id": "l1", "name": "Bronze", "color": "#cd7f32", "rank": 1}
+	],
+	"check_groups": null,
+	"description": "Services",
+	"published": true,
+	"entity_filter_type_identifiers": ["service"],
+	"entity_filter_sql": null,
+	"checks": [
+		{
+			"id": "c1",
+			"name": "Has owner",
+			"description": "",
+			"ordering": 0,
+			"sql": "SELECT 1",
+			"filter_sql": "",
+			"filter_message": "",
+			"output_enabled": false,
+			"output_type": "",
+			"output_aggregation": "",
+			"output_custom_options": "",
+			"estimated_dev_days": 2.5,
+			"external_url": "",
+			"published": true,
+			"scorecard_level_key": "bronze",
+			"level": {"key": "bronze", "id": "l1", "name": "Bronze", "color": "#cd7f32", "rank": 1},
+			"scorecard_check_group_key": null,
+			"check_group": null,
+			"points": null
+		}
+	]
+}`
+
+func TestUpgradeScorecardStateV0toV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	priorSchema := scorecardSchemaV0()
+	raw, err := (&tfprotov6.RawState{JSON: []byte(scorecardStateV0)}).Unmarshal(priorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("unexpected error decoding version 0 state: %s", err)
+	}
+
+	var schemaResp resource.SchemaResponse
+	(&scorecardResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	req := resource.UpgradeStateRequest{State: &tfsdk.State{Schema: priorSchema, Raw: raw}}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgradeScorecardStateV0toV1(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var upgraded scorecardModel
+	if diags := resp.State.Get(ctx, &upgraded); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading upgraded state: %v", diags)
+	}
+
+	if !upgraded.EvaluationFrequency.Equal(types.Int64Value(24)) {
+		t.Errorf("expected evaluation_frequency_hours 24, got %s", upgraded.EvaluationFrequency)
+	}
+	if upgraded.CheckGroups != nil {
+		t.Errorf("expected no check groups, got %v", upgraded.CheckGroups)
+	}
+	if len(upgraded.Levels) != 1 || !upgraded.Levels[0].Rank.Equal(types.Int64Value(1)) || upgraded.Levels[0].Id.ValueString() != "l1" {
+		t.Fatalf("expected level l1 with rank 1, got %v", upgraded.Levels)
+	}
+	if !upgraded.EntityFilterSql.IsNull() {
+		t.Errorf("expected null entity_filter_sql, got %s", upgraded.EntityFilterSql)
+	}
+	if len(upgraded.Checks) != 1 {
+		t.Fatalf("expected one check, got %v", upgraded.Checks)
+	}
+
+	check := upgraded.Checks[0]
+	if !check.Ordering.Equal(types.Int64Value(0)) {
+		t.Errorf("expected ordering 0, got %s", check.Ordering)
+	}
+	if !check.EstimatedDevDays.Equal(types.Int64Value(2)) {
+		t.Errorf("expected estimated_dev_days truncated to 2, got %s", check.EstimatedDevDays)
+	}
+	if !check.Points.IsNull() {
+		t.Errorf("expected null points, got %s", check.Points)
+	}
+	if check.Sql.ValueString() != "SELECT 1" || check.ScorecardLevelKey.ValueString() != "bronze" {
+		t.Errorf("expected sql and level key to be kept, got %s and %s", check.Sql, check.ScorecardLevelKey)
+	}
+	if check.Level == nil || !check.Level.Rank.Equal(types.Int64Value(1)) {
+		t.Errorf("expected nested level with rank 1, got %v", check.Level)
+	}
+	if check.CheckGroup != nil {
+		t.Errorf("expected no nested check group, got %v", check.CheckGroup)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringOutputType is the check output type for free-form text, which has no
+// unit or precision and therefore accepts no custom output options.
+const stringOutputType = "string"
+
+// checkOutputType describes the aggregations and custom output options that
+// a check output type accepts.
+type checkOutputType struct {
+	aggregations []string
+	// units lists the accepted 'unit' options; nil accepts any unit, and an
+	// empty list none.
+	units []string
+	// maxDecimals is the highest accepted 'decimals' option, or -1 if the
+	// type does not accept one.
+	maxDecimals int
+}
+
+// numericAggregations are the aggregations of output types that can be added.
+var numericAggregations = []string{"sum", "average", "median", "min", "max", "count"}
+
+// checkOutputTypes are the output types DX supports. Percentages cannot be
+// summed, integers have no decimals, and durations are counted in whole
+// units.
+var checkOutputTypes = map[string]checkOutputType{
+	stringOutputType: {aggregations: []string{"count"}, units: []string{}, maxDecimals: -1},
+	"integer":        {aggregations: numericAggregations, maxDecimals: 0},
+	"float":          {aggregations: numericAggregations, maxDecimals: math.MaxInt},
+	"percent":        {aggregations: []string{"average", "median", "min", "max", "count"}, units: []string{}, maxDecimals: math.MaxInt},
+	"duration":       {aggregations: numericAggregations, units: []string{"seconds", "minutes", "hours", "days"}, maxDecimals: -1},
+}
+
+func (r *scorecardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Lists that are not known yet are validated once they are.
+	var levels []levelModel
+	if known, diags := getConfigList(ctx, req, "levels", &levels); known {
+		ranks := make([]types.Int64, len(levels))
+		for i, level := range levels {
+			ranks[i] = level.Rank
+		}
+		resp.Diagnostics.Append(validateUniquePositions(path.Root("levels"), "rank", ranks, 1)...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	var checkGroups []checkGroupModel
+	if known, diags := getConfigList(ctx, req, "check_groups", &checkGroups); known {
+		orderings := make([]types.Int64, len(checkGroups))
+		for i, group := range checkGroups {
+			orderings[i] = group.Ordering
+		}
+		resp.Diagnostics.Append(validateUniquePositions(path.Root("check_groups"), "ordering", orderings, 0)...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	var checks []checkModel
+	if known, diags := getConfigList(ctx, req, "checks", &checks); known {
+		orderings := make([]types.Int64, len(checks))
+		for i, check := range checks {
+			orderings[i] = check.Ordering
+			resp.Diagnostics.Append(validateCheckOutput(path.Root("checks").AtListIndex(i), check)...)
+		}
+		resp.Diagnostics.Append(validateUniquePositions(path.Root("checks"), "ordering", orderings, 0)...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	var checksManagement types.String
+	var checksConfig types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("checks_management"), &checksManagement)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("checks"), &checksConfig)...)
+	if checksManagement.ValueString() == checksIgnore && !checksConfig.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("checks"),
+			"Checks are ignored",
+			"'checks' cannot be configured when checks_management is 'ignore'. Use 'additive' to manage only some of the checks of the scorecard.",
+		)
+	}
+}
+
+// getConfigList reads a top-level list attribute of the configuration. It
+// returns false when the list, or any of its elements, is not known yet.
+func getConfigList(ctx context.Context, req resource.ValidateConfigRequest, attribute string, target interface{}) (bool, diag.Diagnostics) {
+	var list types.List
+	diags := req.Config.GetAttribute(ctx, path.Root(attribute), &list)
+	if diags.HasError() || list.IsUnknown() {
+		return false, diags
+	}
+	if list.IsNull() {
+		return true, diags
+	}
+	if d := list.ElementsAs(ctx, target, false); d.HasError() {
+		return false, diags
+	}
+	return true, diags
+}
+
+// validateUniquePositions reports rank or ordering values that are used by more
+// than one element of a list. Unset values take the element's position in the
+// list plus the offset, matching how they are planned.
+func validateUniquePositions(listPath path.Path, attribute string, values []types.Int64, offset int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[int64]int, len(values))
+	for i, value := range values {
+		if value.IsUnknown() {
+			continue
+		}
+
+		position := int64(i) + offset
+		if !value.IsNull() {
+			position = value.ValueInt64()
+		}
+
+		if first, ok := seen[position]; ok {
+			diags.AddAttributeError(
+				listPath.AtListIndex(i).AtName(attribute),
+				fmt.Sprintf("Duplicate %s", attribute),
+				fmt.Sprintf("%s %d is already used by element %d of '%s'. Each element needs a unique %s; unset values default to the element's position in the list (starting at %d).",
+					attribute, position, first, listPath, attribute, offset),
+			)
+			continue
+		}
+		seen[position] = i
+	}
+
+	return diags
+}
+
+// validateCheckOutput validates the output attributes of a check against each
+// other and against the output type. Empty output types and aggregations are
+// treated as unset, since earlier versions required them to be set to an
+// empty string when the output is disabled.
+func validateCheckOutput(checkPath path.Path, check checkModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if check.OutputEnabled.IsUnknown() || check.OutputType.IsUnknown() || check.OutputAggregation.IsUnknown() || check.OutputCustomOptions.IsUnknown() {
+		return diags
+	}
+
+	options, err := check.OutputCustomOptions.object()
+	if err != nil {
+		// Reported by the attribute type.
+		return diags
+	}
+
+	outputType := check.OutputType.ValueString()
+	aggregation := check.OutputAggregation.ValueString()
+
+	if !check.OutputEnabled.ValueBool() {
+		for _, attribute := range []struct {
+			name string
+			set  bool
+		}{
+			{"output_type", outputType != ""},
+			{"output_aggregation", aggregation != ""},
+			{"output_custom_options", len(options) > 0},
+		} {
+			if attribute.set {
+				diags.AddAttributeError(
+					checkPath.AtName(attribute.name),
+					"Check output is disabled",
+					fmt.Sprintf("'%s' can only be set when 'output_enabled' is true.", attribute.name),
+				)
+			}
+		}
+		return diags
+	}
+
+	if outputType == "" {
+		diags.AddAttributeError(
+			checkPath.AtName("output_type"),
+			"Missing output type",
+			"'output_type' must be set when 'output_enabled' is true.",
+		)
+		return diags
+	}
+
+	spec, ok := checkOutputTypes[outputType]
+	if !ok {
+		diags.AddAttributeError(
+			checkPath.AtName("output_type"),
+			"Unsupported output type",
+			fmt.Sprintf("'output_type' must be one of %s, got: %q.", quotedList(sortedKeys(checkOutputTypes)), outputType),
+		)
+		return diags
+	}
+
+	if aggregation != "" && !slices.Contains(spec.aggregations, aggregation) {
+		diags.AddAttributeError(
+			checkPath.AtName("output_aggregation"),
+			"Unsupported output aggregation",
+			fmt.Sprintf("Checks with output type '%s' support the aggregations %s, got: %q.", outputType, quotedList(spec.aggregations), aggregation),
+		)
+	}
+
+	optionsPath := checkPath.AtName("output_custom_options")
+	if unit, ok := options["unit"]; ok {
+		unitString, isString := unit.(string)
+		switch {
+		case spec.units != nil && len(spec.units) == 0:
+			diags.AddAttributeError(optionsPath, "Unsupported output options",
+				fmt.Sprintf("Checks with output type '%s' do not accept a 'unit'.", outputType))
+		case !isString:
+			diags.AddAttributeError(optionsPath, "Invalid output options", "'unit' must be a string.")
+		case spec.units != nil && !slices.Contains(spec.units, unitString):
+			diags.AddAttributeError(optionsPath, "Invalid output options",
+				fmt.Sprintf("'unit' of checks with output type '%s' must be one of %s, got: %q.", outputType, quotedList(spec.units), unitString))
+		}
+	}
+	if decimals, ok := options["decimals"]; ok {
+		n, isNumber := decimals.(float64)
+		switch {
+		case spec.maxDecimals < 0:
+			diags.AddAttributeError(optionsPath, "Unsupported output options",
+				fmt.Sprintf("Checks with output type '%s' do not accept 'decimals'.", outputType))
+		case !isNumber || n < 0 || n != math.Trunc(n):
+			diags.AddAttributeError(optionsPath, "Invalid output options", "'decimals' must be a non-negative integer.")
+		case n > float64(spec.maxDecimals):
+			diags.AddAttributeError(optionsPath, "Invalid output options",
+				fmt.Sprintf("'decimals' of checks with output type '%s' must be at most %d.", outputType, spec.maxDecimals))
+		}
+	}
+
+	return diags
+}
+
+// validateDerivedKey reports an error if a configured key differs from the key
+// derived from the name. DX does not store keys, so levels and check groups
+// are looked up by the key derived from their name.
+func validateDerivedKey(keyPath path.Path, key, name types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if key.IsNull() || key.IsUnknown() || name.IsNull() || name.IsUnknown() {
+		return diags
+	}
+	if expected := slugify(name.ValueString()); key.ValueString() != expected {
+		diags.AddAttributeError(
+			keyPath,
+			"Key does not match the name",
+			fmt.Sprintf("DX does not store keys, so they are derived from the name: the key of %q is '%s', got '%s'. Omit 'key' to derive it.", name.ValueString(), expected, key.ValueString()),
+		)
+	}
+	return diags
+}
+
+// quotedList formats values as a comma-separated list of quoted strings.
+func quotedList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("'%s'", value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateCheckOutput(t *testing.T) {
+	t.Parallel()
+
+	output := func(enabled bool, outputType string, options string) checkModel {
+		check := checkModel{
+			OutputEnabled:       types.BoolValue(enabled),
+			OutputType:          types.StringNull(),
+			OutputAggregation:   types.StringNull(),
+			OutputCustomOptions: newJSONStringValue(options),
+		}
+		if outputType != "" {
+			check.OutputType = types.StringValue(outputType)
+		}
+		return check
+	}
+	// withOutput sets the output type and aggregation as configured, including
+	// empty strings.
+	withOutput := func(check checkModel, outputType, aggregation string) checkModel {
+		check.OutputType = types.StringValue(outputType)
+		check.OutputAggregation = types.StringValue(aggregation)
+		return check
+	}
+
+	testCases := map[string]struct {
+		check     checkModel
+		expectErr bool
+	}{
+		"disabled":                    {check: output(false, "", "")},
+		"disabled-with-type":          {check: output(false, "integer", ""), expectErr: true},
+		"disabled-with-options":       {check: output(false, "", `{"unit":"ms"}`), expectErr: true},
+		"disabled-with-empty-options": {check: output(false, "", "{}")},
+		"enabled-without-type":        {check: output(true, "", ""), expectErr: true},
+		"numeric-with-options":        {check: output(true, "integer", `{"unit":"ms","decimals":0}`)},
+		"numeric-bad-unit":            {check: output(true, "integer", `{"unit":5}`), expectErr: true},
+		"numeric-bad-decimals":        {check: output(true, "float", `{"decimals":1.5}`), expectErr: true},
+		"numeric-negative-decimals":   {check: output(true, "float", `{"decimals":-1}`), expectErr: true},
+		"string-without-options":      {check: output(true, "string", "")},
+		"string-with-options":         {check: output(true, "string", `{"unit":"ms"}`), expectErr: true},
+		"disabled-with-empty-type":    {check: withOutput(output(false, "", ""), "", "")},
+		"enabled-with-empty-type":     {check: withOutput(output(true, "", ""), "", ""), expectErr: true},
+		"unknown-type":                {check: output(true, "text", ""), expectErr: true},
+		"integer-with-decimals":       {check: output(true, "integer", `{"decimals":2}`), expectErr: true},
+		"float-with-decimals":         {check: output(true, "float", `{"unit":"ms","decimals":2}`)},
+		"percent-with-decimals":       {check: output(true, "percent", `{"decimals":1}`)},
+		"percent-with-unit":           {check: output(true, "percent", `{"unit":"ms"}`), expectErr: true},
+		"duration-with-unit":          {check: output(true, "duration", `{"unit":"hours"}`)},
+		"duration-bad-unit":           {check: output(true, "duration", `{"unit":"fortnights"}`), expectErr: true},
+		"duration-with-decimals":      {check: output(true, "duration", `{"decimals":1}`), expectErr: true},
+		"numeric-aggregation":         {check: withOutput(output(true, "float", ""), "float", "median")},
+		"unknown-aggregation":         {check: withOutput(output(true, "float", ""), "float", "p95"), expectErr: true},
+		"summed-percentages":          {check: withOutput(output(true, "percent", ""), "percent", "sum"), expectErr: true},
+		"counted-strings":             {check: withOutput(output(true, "string", ""), "string", "count")},
+		"averaged-strings":            {check: withOutput(output(true, "string", ""), "string", "average"), expectErr: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateCheckOutput(path.Root("checks").AtListIndex(0), testCase.check)
+			if got := diags.HasError(); got != testCase.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectErr, diags)
+			}
+		})
+	}
+}
+
+func TestValidateUniquePositions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values    []types.Int64
+		offset    int64
+		expectErr bool
+	}{
+		"all-unset": {
+			values: []types.Int64{types.Int64Null(), types.Int64Null(), types.Int64Null()},
+		},
+		"all-explicit": {
+			values: []types.Int64{types.Int64Value(3), types.Int64Value(1), types.Int64Value(2)},
+			offset: 1,
+		},
+		"explicit-duplicates": {
+			values:    []types.Int64{types.Int64Value(1), types.Int64Value(1)},
+			expectErr: true,
+		},
+		"explicit-collides-with-position": {
+			values:    []types.Int64{types.Int64Null(), types.Int64Value(0)},
+			expectErr: true,
+		},
+		"explicit-collides-with-offset-position": {
+			values:    []types.Int64{types.Int64Value(2), types.Int64Null()},
+			offset:    1,
+			expectErr: true,
+		},
+		"unknown": {
+			values: []types.Int64{types.Int64Unknown(), types.Int64Unknown()},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateUniquePositions(path.Root("checks"), "ordering", testCase.values, testCase.offset)
+			if got := diags.HasError(); got != testCase.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectErr, diags)
+			}
+		})
+	}
+}
+
+func TestValidateDerivedKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key       types.String
+		name      types.String
+		expectErr bool
+	}{
+		"derived": {
+			key:  types.StringValue("silver-tier"),
+			name: types.StringValue("Silver Tier"),
+		},
+		"unset": {
+			key:  types.StringNull(),
+			name: types.StringValue("Silver Tier"),
+		},
+		"unknown-name": {
+			key:  types.StringValue("silver"),
+			name: types.StringUnknown(),
+		},
+		"different": {
+			key:       types.StringValue("silver"),
+			name:      types.StringValue("Silver Tier"),
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateDerivedKey(path.Root("key"), testCase.key, testCase.name)
+			if got := diags.HasError(); got != testCase.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectErr, diags)
+			}
+		})
+	}
+}
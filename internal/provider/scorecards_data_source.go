@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &scorecardsDataSource{}
+
+func NewScorecardsDataSource() datasource.DataSource {
+	return &scorecardsDataSource{}
+}
+
+// scorecardsDataSource lists the scorecards of the account.
+type scorecardsDataSource struct {
+	client *dxapi.Client
+}
+
+// scorecardsDataSourceModel describes the data source data model.
+type scorecardsDataSourceModel struct {
+	Type                 types.String `tfsdk:"type"`
+	Published            types.Bool   `tfsdk:"published"`
+	NameRegex            types.String `tfsdk:"name_regex"`
+	EntityTypeIdentifier types.String `tfsdk:"entity_type_identifier"`
+
+	Scorecards []scorecardSummaryModel `tfsdk:"scorecards"`
+}
+
+// scorecardSummaryModel summarizes a listed scorecard.
+type scorecardSummaryModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	Published           types.Bool   `tfsdk:"published"`
+	CheckCount          types.Int64  `tfsdk:"check_count"`
+	EvaluationFrequency types.Int64  `tfsdk:"evaluation_frequency_hours"`
+}
+
+func (d *scorecardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecards"
+}
+
+func (d *scorecardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*scorecardProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scorecardProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *scorecardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the DX Scorecards of the account, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list scorecards of this type. Options: 'LEVEL', 'POINTS'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("LEVEL", "POINTS"),
+				},
+			},
+			"published": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list published scorecards when true, or unpublished scorecards when false.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list scorecards whose name matches this regular expression (Go RE2 syntax).",
+				Validators:  []validator.String{regexpValidator{}},
+			},
+			"entity_type_identifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list scorecards that run against this entity type identifier.",
+			},
+			"scorecards": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching scorecards, in the order DX lists them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the scorecard.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the scorecard.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of scorecard, 'LEVEL' or 'POINTS'.",
+						},
+						"published": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the scorecard is published.",
+						},
+						"check_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of checks in the scorecard.",
+						},
+						"evaluation_frequency_hours": schema.Int64Attribute{
+							Computed:    true,
+							Description: "How often the scorecard is evaluated (in hours).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *scorecardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scorecardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := newScorecardFilter(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid filter", err.Error())
+		return
+	}
+
+	scorecards, err := d.client.ListScorecards(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing scorecards", err.Error())
+		return
+	}
+
+	data.Scorecards = []scorecardSummaryModel{}
+	for i := range scorecards {
+		scorecard := &scorecards[i]
+		if !filter.matches(scorecard) {
+			continue
+		}
+		checkCount, _, _ := scorecardCounts(scorecard)
+		data.Scorecards = append(data.Scorecards, scorecardSummaryModel{
+			Id:                  types.StringValue(scorecard.Id),
+			Name:                types.StringValue(scorecard.Name),
+			Type:                types.StringValue(scorecard.Type),
+			Published:           types.BoolValue(scorecard.Published),
+			CheckCount:          checkCount,
+			EvaluationFrequency: types.Int64Value(int64(scorecard.EvaluationFrequency)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// scorecardFilter selects the listed scorecards. Unset criteria match every
+// scorecard.
+type scorecardFilter struct {
+	scorecardType        string
+	published            *bool
+	name                 *regexp.Regexp
+	entityTypeIdentifier string
+}
+
+func newScorecardFilter(data scorecardsDataSourceModel) (scorecardFilter, error) {
+	filter := scorecardFilter{
+		scorecardType:        data.Type.ValueString(),
+		entityTypeIdentifier: data.EntityTypeIdentifier.ValueString(),
+	}
+	if !data.Published.IsNull() {
+		published := data.Published.ValueBool()
+		filter.published = &published
+	}
+	if data.NameRegex.ValueString() != "" {
+		name, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			return filter, fmt.Errorf("name_regex is not a valid regular expression: %w", err)
+		}
+		filter.name = name
+	}
+	return filter, nil
+}
+
+// matches reports whether the scorecard meets every criterion of the filter.
+func (f scorecardFilter) matches(scorecard *dxapi.APIScorecard) bool {
+	if f.scorecardType != "" && scorecard.Type != f.scorecardType {
+		return false
+	}
+	if f.published != nil && scorecard.Published != *f.published {
+		return false
+	}
+	if f.name != nil && !f.name.MatchString(scorecard.Name) {
+		return false
+	}
+	if f.entityTypeIdentifier != "" {
+		for _, identifier := range scorecard.EntityFilterTypeIdentifiers {
+			if identifier != nil && *identifier == f.entityTypeIdentifier {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// regexpValidator validates that a string is a valid regular expression.
+type regexpValidator struct{}
+
+func (v regexpValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			fmt.Sprintf("Attribute %s %s: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-scorecard/internal/provider/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScorecardFilter(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	scorecards := []dxapi.APIScorecard{
+		{Id: "sc1", Name: "Production Readiness", Type: "LEVEL", Published: true, EntityFilterTypeIdentifiers: []*string{str("service")}},
+		{Id: "sc2", Name: "Security", Type: "POINTS", Published: true, EntityFilterTypeIdentifiers: []*string{str("service"), str("library")}},
+		{Id: "sc3", Name: "Production Readiness (Draft)", Type: "LEVEL", Published: false},
+	}
+
+	testCases := map[string]struct {
+		data     scorecardsDataSourceModel
+		expected []string
+	}{
+		"no filter": {
+			data:     scorecardsDataSourceModel{},
+			expected: []string{"sc1", "sc2", "sc3"},
+		},
+		"type": {
+			data:     scorecardsDataSourceModel{Type: types.StringValue("LEVEL")},
+			expected: []string{"sc1", "sc3"},
+		},
+		"unpublished": {
+			data:     scorecardsDataSourceModel{Published: types.BoolValue(false)},
+			expected: []string{"sc3"},
+		},
+		"name regex": {
+			data:     scorecardsDataSourceModel{NameRegex: types.StringValue(`^Production Readiness$`)},
+			expected: []string{"sc1"},
+		},
+		"entity type identifier": {
+			data:     scorecardsDataSourceModel{EntityTypeIdentifier: types.StringValue("library")},
+			expected: []string{"sc2"},
+		},
+		"combined": {
+			data:     scorecardsDataSourceModel{Type: types.StringValue("LEVEL"), Published: types.BoolValue(true), EntityTypeIdentifier: types.StringValue("service")},
+			expected: []string{"sc1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := newScorecardFilter(testCase.data)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for i := range scorecards {
+				if filter.matches(&scorecards[i]) {
+					got = append(got, scorecards[i].Id)
+				}
+			}
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("expected %v, got %v", testCase.expected, got)
+			}
+			for i := range got {
+				if got[i] != testCase.expected[i] {
+					t.Errorf("expected %v, got %v", testCase.expected, got)
+				}
+			}
+		})
+	}
+}
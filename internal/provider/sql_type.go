@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the SQL types fully satisfy framework interfaces.
+var (
+	_ basetypes.StringTypable                    = sqlStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = sqlStringValue{}
+)
+
+// sqlStringType is a string type holding a SQL query. Values of this type are
+// semantically equal when they only differ in whitespace, comments, keyword
+// case or a trailing semicolon, which DX does not preserve when it stores
+// queries.
+type sqlStringType struct {
+	basetypes.StringType
+}
+
+func (t sqlStringType) Equal(o attr.Type) bool {
+	other, ok := o.(sqlStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t sqlStringType) String() string {
+	return "sqlStringType"
+}
+
+func (t sqlStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return sqlStringValue{StringValue: in}, nil
+}
+
+func (t sqlStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t sqlStringType) ValueType(_ context.Context) attr.Value {
+	return sqlStringValue{}
+}
+
+// sqlStringValue is a value of sqlStringType.
+type sqlStringValue struct {
+	basetypes.StringValue
+}
+
+func newSQLStringValue(value string) sqlStringValue {
+	return sqlStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func newSQLStringNull() sqlStringValue {
+	return sqlStringValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v sqlStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(sqlStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v sqlStringValue) Type(_ context.Context) attr.Type {
+	return sqlStringType{}
+}
+
+func (v sqlStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(sqlStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeSQL(v.ValueString()) == normalizeSQL(newValue.ValueString()), diags
+}
+
+// normalizeSQL reduces a query to a canonical form: comments and trailing
+// semicolons are removed, tokens are separated by a single space, and
+// everything outside of quoted literals and identifiers is lower-cased.
+func normalizeSQL(query string) string {
+	var tokens []string
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		// Line comment
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+
+		// Block comment
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/') {
+				i++
+			}
+			i += 2
+
+		// String literals and quoted identifiers are kept verbatim. Doubled
+		// quotes are escapes and do not end the literal.
+		case r == '\'' || r == '"':
+			start := i
+			i++
+			for i < len(runes) {
+				if runes[i] == r {
+					if i+1 < len(runes) && runes[i+1] == r {
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, string(runes[start:min(i, len(runes))]))
+
+		case isSQLWordRune(r):
+			start := i
+			for i < len(runes) && isSQLWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, strings.ToLower(string(runes[start:i])))
+
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+
+	for len(tokens) > 0 && tokens[len(tokens)-1] == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	return strings.Join(tokens, " ")
+}
+
+func isSQLWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '$'
+}
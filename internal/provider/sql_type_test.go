@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSQLStringValueSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			current:  "select 1",
+			new:      "select 1",
+			expected: true,
+		},
+		"trailing-newline": {
+			current:  "SELECT id FROM dx_catalog_entities\n",
+			new:      "SELECT id FROM dx_catalog_entities",
+			expected: true,
+		},
+		"whitespace": {
+			current:  "SELECT id\n  FROM dx_catalog_entities\n  WHERE id=$entity_id",
+			new:      "SELECT id FROM dx_catalog_entities WHERE id = $entity_id",
+			expected: true,
+		},
+		"keyword-case": {
+			current:  "select id from dx_catalog_entities",
+			new:      "SELECT id FROM dx_catalog_entities",
+			expected: true,
+		},
+		"comments": {
+			current:  "-- all entities\nSELECT id /* the id */ FROM dx_catalog_entities;",
+			new:      "SELECT id FROM dx_catalog_entities",
+			expected: true,
+		},
+		"string-literal-case": {
+			current:  "SELECT 'PASS' AS status",
+			new:      "SELECT 'pass' AS status",
+			expected: false,
+		},
+		"string-literal-whitespace": {
+			current:  "SELECT 'a  b' AS status",
+			new:      "SELECT 'a b' AS status",
+			expected: false,
+		},
+		"quoted-identifier-case": {
+			current:  `SELECT "Id" FROM t`,
+			new:      `SELECT "id" FROM t`,
+			expected: false,
+		},
+		"escaped-quote": {
+			current:  "SELECT 'it''s  ok'",
+			new:      "select   'it''s  ok'",
+			expected: true,
+		},
+		"different-query": {
+			current:  "SELECT id FROM a",
+			new:      "SELECT id FROM b",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := newSQLStringValue(testCase.current).StringSemanticEquals(context.Background(), newSQLStringValue(testCase.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}